@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/SlothNinja/log"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -18,26 +17,26 @@ func (g *Game) getForeignLandBox(c *gin.Context, formValue string) (*ForeignLand
 
 	landParams := strings.Split(c.PostForm(formValue), "-")
 	if landParams[0] == "None" {
-		return nil, sn.NewVError("You must select a land from which to redeploy an army.")
+		return nil, g.vError("error.must-select-land-redeploy-army")
 	}
 
 	landIndex, err1 := strconv.Atoi(landParams[0])
 	if err1 != nil {
-		return nil, sn.NewVError("Invalid value received for %q tile.", formValue)
+		return nil, g.vError("error.invalid-tile-value", formValue)
 	}
 
 	boxIndex, err1 := strconv.Atoi(landParams[1])
 	if err1 != nil {
-		return nil, sn.NewVError("Invalid value received for %q box.", formValue)
+		return nil, g.vError("error.invalid-box-value", formValue)
 	}
 
 	if landIndex < 0 || landIndex > 2 {
-		return nil, sn.NewVError("Invalid value recieved for %q tile.", formValue)
+		return nil, g.vError("error.invalid-tile-value", formValue)
 	}
 
 	land := g.ForeignLand(landIndex)
 	if boxIndex < 0 || boxIndex >= len(g.ForeignLand(landIndex).Boxes) {
-		return nil, sn.NewVError("Invalid value recieved for %q box: boxIndex: %d, Boxes length: %d.", formValue, boxIndex, len(g.ForeignLand(landIndex).Boxes))
+		return nil, g.vError("error.invalid-box-index", formValue, boxIndex, len(g.ForeignLand(landIndex).Boxes))
 	}
 
 	return land.Box(boxIndex), nil
@@ -73,24 +72,24 @@ func (g *Game) getSpaceID(c *gin.Context) (SpaceID, error) {
 		"avenge-emperor", "take-army", "transfer-influence":
 		return NoSpace, nil
 	default:
-		return NoSpace, sn.NewVError("%q is an invalid action.", a)
+		return NoSpace, g.vError("error.invalid-action", a)
 	}
 }
 
 func (g *Game) getMinistryAndSeniority(c *gin.Context, formValue string) (*Ministry, Seniority, error) {
 	param := c.PostForm(formValue)
 	if param == "None" {
-		return nil, 0, sn.NewVError("You must select an official.")
+		return nil, 0, g.vError("error.must-select-official")
 	}
 
 	ss := strings.SplitN(param, "-", 2)
 	if len(ss) != 2 {
-		return nil, 0, sn.NewVError("Invalid format for ministry/seniority param.")
+		return nil, 0, g.vError("error.invalid-format-ministry-seniority-param")
 	}
 
 	i, err := strconv.Atoi(ss[1])
 	if err != nil {
-		return nil, 0, sn.NewVError("Invalid Official Seniority Provided.")
+		return nil, 0, g.vError("error.invalid-official-seniority-provided")
 	}
 
 	s := Seniority(i)
@@ -102,7 +101,7 @@ func (g *Game) getMinistryAndSeniority(c *gin.Context, formValue string) (*Minis
 	case "Gongbu":
 		return g.Ministries[Gongbu], s, nil
 	default:
-		return nil, 0, sn.NewVError("Invalid Ministry Provided.")
+		return nil, 0, g.vError("error.invalid-ministry-provided")
 	}
 }
 
@@ -114,7 +113,7 @@ func (g *Game) getMinistryAndOfficial(c *gin.Context, formValue string) (*Minist
 
 	o, ok := m.Officials[s]
 	if !ok {
-		return nil, nil, sn.NewVError("Invalid official selected.")
+		return nil, nil, g.vError("error.invalid-official-selected")
 	}
 
 	return m, o, nil
@@ -125,14 +124,14 @@ func (g *Game) getPlayer(c *gin.Context, formValue string) (*Player, error) {
 
 	switch sid {
 	case "":
-		return nil, sn.NewVError("Player form value %q not found.", formValue)
+		return nil, g.vError("error.player-form-value-not-found", formValue)
 	case "none":
-		return nil, sn.NewVError("You must select a player.")
+		return nil, g.vError("error.must-select-player")
 	}
 
 	p := g.PlayerBySID(sid)
 	if p == nil {
-		return nil, sn.NewVError("You must select a player.")
+		return nil, g.vError("error.must-select-player")
 	}
 
 	return p, nil
@@ -143,7 +142,7 @@ func (g *Game) getGiftValue(c *gin.Context, formValue string) (GiftCardValue, er
 
 	gi, err := strconv.Atoi(c.PostForm(formValue))
 	if err != nil {
-		return 0, sn.NewVError("You must select an gift card.")
+		return 0, g.vError("error.must-select-gift-card")
 	}
 
 	return GiftCardValue(gi), nil
@@ -153,13 +152,13 @@ func (g *Game) getRewardCard(c *gin.Context) (*EmperorCard, error) {
 
 	t, err := strconv.Atoi(c.PostForm("reward-card"))
 	if err != nil {
-		return nil, sn.NewVError("You must select an Emperor's Reward card.")
+		return nil, g.vError("error.must-select-emperors-reward-card")
 	}
 
 	cp := g.CurrentPlayer()
 	cd := cp.GetEmperorCard(EmperorCardType(t))
 	if cd == nil {
-		return nil, sn.NewVError("You don't have the selected Emperor's Reward card.")
+		return nil, g.vError("error.dont-selected-emperors-reward-card")
 	}
 	return cd, nil
 }
@@ -170,32 +169,32 @@ func (g *Game) getConCards(c *gin.Context, formValue string) (ConCards, error) {
 
 	c1, err := strconv.Atoi(c.PostForm(formValue + "-coins1"))
 	if err != nil || c1 < 0 {
-		return nil, sn.NewVError("Invalid value for Coin 1 cards received.")
+		return nil, g.vError("error.invalid-value-coin-1-cards-received")
 	}
 
 	c2, err := strconv.Atoi(c.PostForm(formValue + "-coins2"))
 	if err != nil || c2 < 0 {
-		return nil, sn.NewVError("Invalid value for Coin 2 cards received.")
+		return nil, g.vError("error.invalid-value-coin-2-cards-received")
 	}
 
 	c3, err := strconv.Atoi(c.PostForm(formValue + "-coins3"))
 	if err != nil || c3 < 0 {
-		return nil, sn.NewVError("Invalid value for Coin 3 cards received.")
+		return nil, g.vError("error.invalid-value-coin-3-cards-received")
 	}
 
 	c1Cnt := cp.CardCount(1)
 	if c1 > c1Cnt {
-		return nil, sn.NewVError("You selected %d cards with one coin, but only have %d of such cards.", c1, c1Cnt)
+		return nil, g.vError("error.selected-cards-one-coin-but-only", c1, c1Cnt)
 	}
 
 	c2Cnt := cp.CardCount(2)
 	if c2 > c2Cnt {
-		return nil, sn.NewVError("You selected %d cards with two coins, but only have %d of such cards.", c2, c2Cnt)
+		return nil, g.vError("error.selected-cards-two-coins-but-only", c2, c2Cnt)
 	}
 
 	c3Cnt := cp.CardCount(3)
 	if c3 > c3Cnt {
-		return nil, sn.NewVError("You selected %d cards with three coins, but only have %d of such cards.", c3, c3Cnt)
+		return nil, g.vError("error.selected-cards-three-coins-but-only", c3, c3Cnt)
 	}
 	return ConCards{}.AppendN(1, c1).AppendN(2, c2).AppendN(3, c3), nil
 }
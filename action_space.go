@@ -64,9 +64,14 @@ func (p *Player) PlaceCubesIn(id SpaceID, cubes int) {
 }
 
 func (p *Player) RequiredCubesFor(id SpaceID) int {
-	switch space := p.Game().ActionSpaces[id]; {
-	case space == nil:
+	if p.Game().ActionSpaces[id] == nil {
 		return 0
+	}
+	return p.Game().variantRequiredCubesFor(p, id, p.requiredCubesFor(id))
+}
+
+func (p *Player) requiredCubesFor(id SpaceID) int {
+	switch space := p.Game().ActionSpaces[id]; {
 	case p.Game().Phase == Actions:
 		switch {
 		case p.Game().ExtraAction:
@@ -16,8 +16,10 @@ func (g *Game) invokeInvadePhase(c *gin.Context) (string, game.ActionType, error
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.invasionPhase(c)
-	return "", game.Cache, nil
+	if err := g.invasionPhase(c); err != nil {
+		return "", game.None, err
+	}
+	return "", game.Save, nil
 }
 
 func (g *Game) adminHeader(c *gin.Context, cu *user.User) (string, game.ActionType, error) {
@@ -56,15 +58,14 @@ func (g *Game) adminHeader(c *gin.Context, cu *user.User) (string, game.ActionTy
 	}
 
 	s := struct {
-		Junks           int  `form:"junks"`
-		ChiefMinisterID int  `form:"chief-minister-id"`
-		AdmiralID       int  `form:"admiral-id"`
-		GeneralID       int  `form:"general-id"`
-		AvengerID       int  `form:"avenger-id"`
-		Wall            int  `form:"wall"`
-		ExtraAction     bool `form:"extra-action"`
-		BasicGame       bool `form:"basic-game"`
-		AdmiralVariant  bool `form:"admiral-variant"`
+		Junks           int   `form:"junks"`
+		ChiefMinisterID int   `form:"chief-minister-id"`
+		AdmiralID       int   `form:"admiral-id"`
+		GeneralID       int   `form:"general-id"`
+		AvengerID       int   `form:"avenger-id"`
+		Wall            int   `form:"wall"`
+		ExtraAction     bool  `form:"extra-action"`
+		Variants        []int `form:"variants"`
 	}{}
 	err = c.ShouldBind(&s)
 	if err != nil {
@@ -85,6 +86,11 @@ func (g *Game) adminHeader(c *gin.Context, cu *user.User) (string, game.ActionTy
 
 	g.Title = h.Title
 	g.Turn = h.Turn
+	// An admin override: admins repair games the phase machine cannot advance, so the phase is set
+	// as given, without the hooks of setPhase.  Changes the machine would refuse are logged.
+	if err := g.checkPhaseChange(g.Phase, h.Phase); h.Phase != g.Phase && err != nil {
+		log.Warningf("game %d: admin overrides phase change: %s", g.ID(), err)
+	}
 	g.Phase = h.Phase
 	g.SubPhase = h.SubPhase
 	g.Round = h.Round
@@ -102,6 +108,9 @@ func (g *Game) adminHeader(c *gin.Context, cu *user.User) (string, game.ActionTy
 	g.AdmiralID = s.AdmiralID
 	g.GeneralID = s.GeneralID
 	g.Wall = s.Wall
+	if _, ok := c.GetPostFormArray("variants"); ok {
+		g.setVariants(variantsFromForm(s.Variants, false, false))
+	}
 
 	return "", game.Save, nil
 }
@@ -348,7 +357,9 @@ func (g *Game) adminPlayer(c *gin.Context, cu *user.User) (string, game.ActionTy
 			if score, err = strconv.Atoi(value[0]); err != nil {
 				return "", game.None, err
 			}
-			player.Score = score
+			if score != player.Score {
+				player.addScore(AdminScore, score-player.Score)
+			}
 		case "actioncubes":
 			var actioncubes int
 			if actioncubes, err = strconv.Atoi(value[0]); err != nil {
@@ -0,0 +1,455 @@
+package confucius
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"image"
+	imgcolor "image/color"
+	"image/png"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/SlothNinja/color"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	imgfixed "golang.org/x/image/math/fixed"
+)
+
+// Kinds of the shapes from which the board is drawn.
+const (
+	rectShape = iota
+	circleShape
+	textShape
+)
+
+// boardShape is a shape of the board drawing.  Rectangles are positioned by their top left
+// corner, circles and text by their centre.
+type boardShape struct {
+	kind   int
+	x, y   float64
+	w, h   float64
+	r      float64
+	fill   string
+	stroke string
+	text   string
+	size   int
+}
+
+// boardDrawing lists the shapes of the board in the order in which they are painted, so the SVG and
+// PNG renderings are drawn from the same layout.
+type boardDrawing struct {
+	width, height int
+	shapes        []boardShape
+}
+
+func (d *boardDrawing) rect(x, y, w, h float64, fill, stroke string) {
+	d.shapes = append(d.shapes, boardShape{kind: rectShape, x: x, y: y, w: w, h: h, fill: fill, stroke: stroke})
+}
+
+func (d *boardDrawing) circle(x, y, r float64, fill, stroke string) {
+	d.shapes = append(d.shapes, boardShape{kind: circleShape, x: x, y: y, r: r, fill: fill, stroke: stroke})
+}
+
+func (d *boardDrawing) label(x, y float64, size int, s string) {
+	d.shapes = append(d.shapes, boardShape{kind: textShape, x: x, y: y, size: size, fill: boardInk, text: s})
+}
+
+const (
+	boardWidth    = 900
+	boardMargin   = 20
+	boardSlot     = 36
+	boardInk      = "#333333"
+	boardPaper    = "#f5ecd7"
+	boardResolved = "#c8c8c8"
+	boardEmpty    = "#ffffff"
+	boardWall     = "#8b5a2b"
+)
+
+// Fill colours of the player colours.
+var boardColors = map[color.Color]string{
+	color.Red:    "#d62728",
+	color.Yellow: "#f2d22e",
+	color.Purple: "#7b3fa0",
+	color.Black:  "#222222",
+	color.Brown:  "#8c564b",
+	color.White:  "#fafafa",
+	color.Green:  "#2ca02c",
+	color.Orange: "#ff7f0e",
+}
+
+// boardColor returns the fill colour of the player, as seen by the user cu.
+func (g *Game) boardColor(p *Player, cu *user.User) string {
+	if c, ok := boardColors[g.Color(p, cu)]; ok {
+		return c
+	}
+	return boardEmpty
+}
+
+// wallLength returns the number of sections of the Great Wall that end the game.
+func (g *Game) wallLength() int {
+	if g.HasVariant(ShortWallVariant) {
+		return 7
+	}
+	return 9
+}
+
+// drawBoard lays out the board as seen by the user cu.  It reads only the game passed in, so a
+// game projected for a viewer, or rebuilt for a replay, is drawn as it stands.
+func (g *Game) drawBoard(cu *user.User) *boardDrawing {
+	d := &boardDrawing{width: boardWidth}
+	y := float64(boardMargin)
+	y = g.drawMinistries(d, cu, y)
+	y = g.drawCandidates(d, cu, y)
+	y = g.drawWall(d, y)
+	y = g.drawForeignLands(d, cu, y)
+	y = g.drawDistantLands(d, cu, y)
+	y = g.drawActionSpaces(d, cu, y)
+	d.height = int(y) + boardMargin
+	d.shapes = append([]boardShape{{kind: rectShape, w: float64(d.width), h: float64(d.height), fill: boardPaper}},
+		d.shapes...)
+	return d
+}
+
+func (g *Game) drawMinistries(d *boardDrawing, cu *user.User, y float64) float64 {
+	l := g.Locale()
+	width := float64(boardWidth-2*boardMargin) / float64(len(ministeryIDS))
+	bottom := y
+	for i, id := range ministeryIDS {
+		m, ok := g.Ministries[id]
+		if !ok {
+			continue
+		}
+
+		x := boardMargin + float64(i)*width
+		fill := boardEmpty
+		if m.Resolved {
+			fill = boardResolved
+		}
+		d.rect(x, y, width-10, boardSlot+50, fill, boardInk)
+		d.label(x+(width-10)/2, y+16, 14, fmt.Sprintf("%s (%d/%d)", l.Name(m.Name()),
+			m.MinisterChit.Value(), m.SecretaryChit.Value()))
+
+		seniorities := make(Seniorities, 0, len(m.Officials))
+		for seniority := range m.Officials {
+			seniorities = append(seniorities, seniority)
+		}
+		sort.Slice(seniorities, func(i, j int) bool { return seniorities[i] < seniorities[j] })
+
+		for j, seniority := range seniorities {
+			o := m.Officials[seniority]
+			sx := x + 8 + float64(j)*(boardSlot+4)
+			sy := y + 28
+			d.rect(sx, sy, boardSlot, boardSlot, boardEmpty, boardInk)
+			d.label(sx+boardSlot/2, sy+boardSlot+12, 10, fmt.Sprintf("%d:%d", seniority, o.Cost))
+			if p := o.Player(); p != nil {
+				stroke := boardInk
+				if o.Secured {
+					// Secured markers are ringed, as they sit on their sides on the board.
+					d.circle(sx+boardSlot/2, sy+boardSlot/2, boardSlot/2-2, boardInk, boardInk)
+					stroke = boardEmpty
+				}
+				d.circle(sx+boardSlot/2, sy+boardSlot/2, boardSlot/2-6, g.boardColor(p, cu), stroke)
+			}
+			if temp := o.TempPlayer(); temp != nil {
+				d.circle(sx+boardSlot-6, sy+6, 5, g.boardColor(temp, cu), boardInk)
+			}
+		}
+		bottom = math.Max(bottom, y+boardSlot+50)
+	}
+	return bottom + 10
+}
+
+func (g *Game) drawCandidates(d *boardDrawing, cu *user.User, y float64) float64 {
+	l := g.Locale()
+	d.label(boardMargin+60, y+boardSlot/2+4, 12, l.Sprintf("board.candidates"))
+	x := float64(boardMargin + 130)
+	for i, c := range g.Candidates {
+		fill := boardResolved
+		if i == 0 && c.Variant != TileBack {
+			fill = boardEmpty
+		}
+		d.rect(x, y, boardSlot, boardSlot, fill, boardInk)
+		if i == 0 && c.Variant != TileBack {
+			var initials string
+			for _, id := range ministeryIDS {
+				if _, ok := g.MinistriesFor(c)[id]; ok {
+					initials += g.Ministries[id].Name()[:1]
+				}
+			}
+			d.label(x+boardSlot/2, y+14, 10, initials)
+			for j, p := range []*Player{c.Player(), c.OtherPlayer()} {
+				if p != nil {
+					d.circle(x+10+float64(j)*16, y+boardSlot-9, 6, g.boardColor(p, cu), boardInk)
+				}
+			}
+		}
+		x += boardSlot + 4
+	}
+	return y + boardSlot + 14
+}
+
+func (g *Game) drawWall(d *boardDrawing, y float64) float64 {
+	d.label(boardMargin+60, y+boardSlot/4+4, 12, g.Locale().Sprintf("board.wall"))
+	x := float64(boardMargin + 130)
+	for i := 0; i < g.wallLength(); i++ {
+		fill := boardEmpty
+		if i < g.Wall {
+			fill = boardWall
+		}
+		d.rect(x, y, boardSlot, boardSlot/2, fill, boardInk)
+		x += boardSlot + 4
+	}
+	return y + boardSlot/2 + 14
+}
+
+func (g *Game) drawForeignLands(d *boardDrawing, cu *user.User, y float64) float64 {
+	if len(g.ForeignLands) == 0 {
+		return y
+	}
+
+	l := g.Locale()
+	width := float64(boardWidth-2*boardMargin) / float64(len(g.ForeignLands))
+	for i, land := range g.ForeignLands {
+		x := boardMargin + float64(i)*width
+		fill := boardEmpty
+		if land.Resolved {
+			fill = boardResolved
+		}
+		d.rect(x, y, width-10, boardSlot+40, fill, boardInk)
+		d.label(x+(width-10)/2, y+16, 12, fmt.Sprintf("%s (%d)", l.Name(land.Name()), land.Cost()))
+		for j, box := range land.Boxes {
+			bx := x + 8 + float64(j)*(boardSlot+4)
+			by := y + 26
+			d.rect(bx, by, boardSlot, boardSlot, boardEmpty, boardInk)
+			s := strconv.Itoa(box.Points)
+			if box.AwardCard {
+				s += "+"
+			}
+			d.label(bx+boardSlot/2, by+12, 10, s)
+			if p := box.Player(); p != nil {
+				d.rect(bx+8, by+16, boardSlot-16, boardSlot-20, g.boardColor(p, cu), boardInk)
+			}
+		}
+	}
+	return y + boardSlot + 50
+}
+
+func (g *Game) drawDistantLands(d *boardDrawing, cu *user.User, y float64) float64 {
+	if len(g.DistantLands) == 0 {
+		return y
+	}
+
+	l := g.Locale()
+	width := float64(boardWidth-2*boardMargin) / float64(len(g.DistantLands))
+	for i, land := range g.DistantLands {
+		x := boardMargin + float64(i)*width
+		d.rect(x, y, width-10, boardSlot+40, boardEmpty, boardInk)
+		d.label(x+(width-10)/2, y+16, 12, l.Name(land.Name()))
+		cx, cy := x+8+boardSlot/2, y+26+boardSlot/2
+		if land.Chit == NoChit {
+			d.circle(cx, cy, boardSlot/2-2, boardResolved, boardInk)
+		} else {
+			d.circle(cx, cy, boardSlot/2-2, boardEmpty, boardInk)
+			d.label(cx, cy+4, 12, strconv.Itoa(land.Chit.Value()))
+		}
+		for j, p := range land.Players() {
+			d.rect(x+boardSlot+16+float64(j)*20, y+36, 16, 12, g.boardColor(p, cu), boardInk)
+		}
+	}
+	return y + boardSlot + 50
+}
+
+// boardSpaces lists the action spaces, and the ids of their labels, in the order in which they are drawn.
+var boardSpaces = []struct {
+	id    SpaceID
+	label string
+}{
+	{BribeSecureSpace, "board.bribe-secure"},
+	{NominateSpace, "board.nominate"},
+	{ForceSpace, "board.force"},
+	{JunksVoyageSpace, "board.junks-voyage"},
+	{RecruitArmySpace, "board.recruit-army"},
+	{BuyGiftSpace, "board.buy-gift"},
+	{GiveGiftSpace, "board.give-gift"},
+	{PetitionSpace, "board.petition"},
+	{CommercialSpace, "board.commercial"},
+	{TaxIncomeSpace, "board.tax-income"},
+	{NoActionSpace, "board.no-action"},
+	{ImperialFavourSpace, "board.imperial-favour"},
+}
+
+func (g *Game) drawActionSpaces(d *boardDrawing, cu *user.User, y float64) float64 {
+	l := g.Locale()
+	width := float64(boardWidth-2*boardMargin) / 6
+	for i, bs := range boardSpaces {
+		space, ok := g.ActionSpaces[bs.id]
+		if !ok {
+			continue
+		}
+
+		x := boardMargin + float64(i%6)*width
+		sy := y + float64(i/6)*(boardSlot+20)
+		d.rect(x, sy, width-10, boardSlot+10, boardEmpty, boardInk)
+		d.label(x+(width-10)/2, sy+14, 10, l.Sprintf(bs.label))
+		cx := x + 6
+		for _, p := range g.Players() {
+			for n := 0; n < space.Cubes[p.ID()]; n++ {
+				d.rect(cx, sy+22, 12, 12, g.boardColor(p, cu), boardInk)
+				cx += 15
+			}
+		}
+	}
+	return y + 2*(boardSlot+20)
+}
+
+// SVG renders the drawing.
+func (d *boardDrawing) SVG() []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		d.width, d.height, d.width, d.height)
+	for _, s := range d.shapes {
+		stroke := ""
+		if s.stroke != "" {
+			stroke = fmt.Sprintf(` stroke="%s" stroke-width="1.5"`, s.stroke)
+		}
+		switch s.kind {
+		case rectShape:
+			fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"%s />`,
+				s.x, s.y, s.w, s.h, s.fill, stroke)
+		case circleShape:
+			fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="%.1f" fill="%s"%s />`, s.x, s.y, s.r, s.fill, stroke)
+		case textShape:
+			fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="%d" font-family="sans-serif" text-anchor="middle" fill="%s">%s</text>`,
+				s.x, s.y, s.size, s.fill, template.HTMLEscapeString(s.text))
+		}
+	}
+	b.WriteString(`</svg>`)
+	return b.Bytes()
+}
+
+// PNG rasterises the drawing.  Text is drawn in a fixed size bitmap font, whatever its size in
+// the SVG rendering.
+func (d *boardDrawing) PNG() ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, d.width, d.height))
+	for _, s := range d.shapes {
+		switch s.kind {
+		case rectShape:
+			fillRect(img, s.x, s.y, s.w, s.h, hexColor(s.fill))
+			if s.stroke != "" {
+				c := hexColor(s.stroke)
+				fillRect(img, s.x, s.y, s.w, 1, c)
+				fillRect(img, s.x, s.y+s.h-1, s.w, 1, c)
+				fillRect(img, s.x, s.y, 1, s.h, c)
+				fillRect(img, s.x+s.w-1, s.y, 1, s.h, c)
+			}
+		case circleShape:
+			if s.stroke != "" {
+				fillCircle(img, s.x, s.y, s.r, hexColor(s.stroke))
+				fillCircle(img, s.x, s.y, s.r-1, hexColor(s.fill))
+			} else {
+				fillCircle(img, s.x, s.y, s.r, hexColor(s.fill))
+			}
+		case textShape:
+			drawText(img, s.x, s.y, s.text, hexColor(s.fill))
+		}
+	}
+
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func fillRect(img *image.RGBA, x, y, w, h float64, c imgcolor.RGBA) {
+	r := image.Rect(int(x), int(y), int(x+w), int(y+h)).Intersect(img.Bounds())
+	for py := r.Min.Y; py < r.Max.Y; py++ {
+		for px := r.Min.X; px < r.Max.X; px++ {
+			img.SetRGBA(px, py, c)
+		}
+	}
+}
+
+func fillCircle(img *image.RGBA, cx, cy, radius float64, c imgcolor.RGBA) {
+	r := image.Rect(int(cx-radius), int(cy-radius), int(cx+radius)+1, int(cy+radius)+1).Intersect(img.Bounds())
+	for py := r.Min.Y; py < r.Max.Y; py++ {
+		for px := r.Min.X; px < r.Max.X; px++ {
+			if math.Hypot(float64(px)+0.5-cx, float64(py)+0.5-cy) <= radius {
+				img.SetRGBA(px, py, c)
+			}
+		}
+	}
+}
+
+// drawText draws the text centred on x with its baseline at y, as the SVG rendering anchors text.
+func drawText(img *image.RGBA, x, y float64, s string, c imgcolor.RGBA) {
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: basicfont.Face7x13}
+	d.Dot = imgfixed.Point26_6{X: imgfixed.I(int(x)) - d.MeasureString(s)/2, Y: imgfixed.I(int(y))}
+	d.DrawString(s)
+}
+
+// hexColor parses a colour of the form #rrggbb.
+func hexColor(s string) imgcolor.RGBA {
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if len(s) != 7 || err != nil {
+		return imgcolor.RGBA{A: 0xff}
+	}
+	return imgcolor.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}
+}
+
+// BoardSVG renders the board as seen by the user cu, for emails, replays and link previews.
+func (g *Game) BoardSVG(cu *user.User) []byte {
+	return g.drawBoard(cu).SVG()
+}
+
+// BoardPNG rasterises the board as seen by the user cu, for clients that do not display SVG.
+func (g *Game) BoardPNG(cu *user.User) ([]byte, error) {
+	return g.drawBoard(cu).PNG()
+}
+
+func (client *Client) boardSVG(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	c.Data(http.StatusOK, "image/svg+xml", g.BoardSVG(cu))
+}
+
+func (client *Client) boardPNG(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	b, err := g.BoardPNG(cu)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Data(http.StatusOK, "image/png", b)
+}
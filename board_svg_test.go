@@ -0,0 +1,46 @@
+package confucius
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+)
+
+func TestBoardPNGDrawsText(t *testing.T) {
+	d := &boardDrawing{width: 100, height: 40}
+	d.rect(0, 0, 100, 40, boardPaper, "")
+	d.label(50, 25, 12, "Hubu")
+
+	b, err := d.PNG()
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ink := hexColor(boardInk)
+	minX, maxX := d.width, -1
+	for y := 0; y < d.height; y++ {
+		for x := 0; x < d.width; x++ {
+			r, g, bl, _ := img.At(x, y).RGBA()
+			if uint8(r>>8) == ink.R && uint8(g>>8) == ink.G && uint8(bl>>8) == ink.B {
+				if x < minX {
+					minX = x
+				}
+				if x > maxX {
+					maxX = x
+				}
+			}
+		}
+	}
+
+	if maxX < 0 {
+		t.Fatal("no text drawn")
+	}
+	// The label is centred on x = 50.
+	if left, right := 50-minX, maxX-50; left-right > 7 || right-left > 7 {
+		t.Errorf("text from x = %d to %d is not centred on 50", minX, maxX)
+	}
+}
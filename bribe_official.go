@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -26,24 +25,30 @@ func (g *Game) bribeOfficial(c *gin.Context, cu *user.User) (string, game.Action
 	}
 
 	cp := g.CurrentPlayer()
-	cp.PerformedAction = true
 
 	// Place Action Cubes
 	cp.PlaceCubesIn(BribeSecureSpace, cubes)
 
+	entry := cp.bribeOfficial(ministry, official, cards)
+
+	// Set flash message
+	restful.AddNoticef(c, string(entry.HTML()))
+	return "", game.Cache, nil
+}
+
+func (p *Player) bribeOfficial(ministry *Ministry, official *OfficialTile, cards ConCards) *bribeOfficialEntry {
+	g := p.Game()
+	p.PerformedAction = true
+
 	// Place Marker On Official
-	official.setPlayer(cp)
+	official.setPlayer(p)
 
 	// Move played cards from hand to discard pile
-	cp.ConCardHand.Remove(cards...)
+	p.ConCardHand.Remove(cards...)
 	g.ConDiscardPile.Append(cards...)
 
 	// Create Action Object for logging
-	entry := cp.newBribeOfficialEntry(ministry, official, cards)
-
-	// Set flash message
-	restful.AddNoticef(c, string(entry.HTML()))
-	return "", game.Cache, nil
+	return p.newBribeOfficialEntry(ministry, official, cards)
 }
 
 type bribeOfficialEntry struct {
@@ -66,9 +71,18 @@ func (p *Player) newBribeOfficialEntry(m *Ministry, o *OfficialTile, cs ConCards
 }
 
 func (g *bribeOfficialEntry) HTML() template.HTML {
+	l := g.locale()
 	length := len(g.Played)
-	return restful.HTML("%s spent %d %s having %d coins to bribe %s official with level %d seniority.",
-		g.Player().Name(), length, pluralize("card", length), g.Played.Coins(), g.MinistryName, g.Seniority)
+	if length == 0 {
+		return l.HTML("entry.bribe-official",
+			g.Player().Name(), l.Name(g.MinistryName), g.Seniority)
+	}
+	return l.HTML("entry.bribe-official-paid",
+		g.Player().Name(), length, l.Plural("card", length), g.Played.Coins(), l.Name(g.MinistryName), g.Seniority)
+}
+
+func (e *bribeOfficialEntry) influenceChanges() map[int]int {
+	return map[int]int{e.PlayerID: 1}
 }
 
 func (g *Game) validateBribeOfficial(c *gin.Context, cu *user.User) (ConCards, *Ministry, *OfficialTile, int, error) {
@@ -77,26 +91,26 @@ func (g *Game) validateBribeOfficial(c *gin.Context, cu *user.User) (ConCards, *
 		return nil, nil, nil, 0, err
 	}
 
-	cds, err := g.getConCards(c, "bribe-official")
+	m, o, err := g.getMinistryAndOfficial(c, "bribe-official")
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
 
-	m, o, err := g.getMinistryAndOfficial(c, "bribe-official")
+	cp := g.CurrentPlayer()
+	cds, err := g.getPayment(c, "bribe-official", o.CostFor(cp), payCoins)
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
 
-	cp := g.CurrentPlayer()
 	gp := cp.hasGiftObligationIn(m)
 
 	switch {
 	case gp != nil:
-		return nil, nil, nil, 0, sn.NewVError("You have a gift obligation to %s that prevents you from bribing another official in the %s ministry.", g.NameFor(gp), m.Name())
+		return nil, nil, nil, 0, g.vError("error.gift-obligation-prevents-bribing-another-official", g.NameFor(gp), m.Name())
 	case o.Bribed():
-		return nil, nil, nil, 0, sn.NewVError("You can't bribe an official that already has a marker.")
+		return nil, nil, nil, 0, g.vError("error.cant-bribe-official-already-marker")
 	case cds.Coins() < o.CostFor(cp):
-		return nil, nil, nil, 0, sn.NewVError("You selected cards having %d total coins, but you need %d coins to bribe the selected official.", cds.Coins(), cp.CostFor(o))
+		return nil, nil, nil, 0, g.vError("error.insufficient-coins-bribe", cds.Coins(), cp.CostFor(o))
 	default:
 		return cds, m, o, cbs, nil
 	}
@@ -114,27 +128,39 @@ func (p *Player) hasGiftObligationIn(m *Ministry) *Player {
 }
 
 func (g *Game) EnableBribeOfficial(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canBribeAnOfficial()
+	return g.BribeOfficialReasons(cu).None()
 }
 
-func (p *Player) canBribeAnOfficial() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction && p.hasEnoughCubesFor(BribeSecureSpace) &&
-		g.hasBribableOfficialFor(p)
+// BribeOfficialReasons returns why the user cu may not bribe an official.
+func (g *Game) BribeOfficialReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, BribeSecureSpace)
+	if cost, ok := g.cheapestOfficialFor(cp, func(o *OfficialTile) bool { return o.NotBribed() }); ok {
+		r.coins(cp, cost)
+	} else {
+		r.require(false, "reason.no-bribable-official")
+	}
+	return r.reasons
 }
 
-func (g *Game) hasBribableOfficialFor(p *Player) bool {
+// cheapestOfficialFor returns the least cost to the player of an official of an unresolved ministry
+// passing the test, reporting whether there is one.
+func (g *Game) cheapestOfficialFor(p *Player, test OfficialTest) (int, bool) {
+	cost, found := 0, false
 	for _, m := range g.Ministries {
-		if !m.Resolved {
-			for _, o := range m.Officials {
-				if o.NotBribed() && p.canAffordToBribe(o) {
-					return true
-				}
+		if m.Resolved {
+			continue
+		}
+		for _, o := range m.Officials {
+			if test(o) && (!found || o.CostFor(p) < cost) {
+				cost, found = o.CostFor(p), true
 			}
 		}
 	}
-	return false
+	return cost, found
 }
 
 func (p *Player) canAffordToBribe(o *OfficialTile) bool {
@@ -4,11 +4,13 @@ import (
 	"github.com/SlothNinja/log"
 )
 
-func (g *Game) buildWallPhase() {
+func (g *Game) buildWallPhase() error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = BuildWall
-	g.beginningOfPhaseReset()
+	if err := g.setPhase(BuildWall); err != nil {
+		return err
+	}
 	g.Wall += 1
+	return nil
 }
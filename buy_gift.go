@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -68,9 +67,10 @@ func (p *Player) newBuyGiftEntry(gc *GiftCard, played ConCards) *buyGiftEntry {
 }
 
 func (e *buyGiftEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
-	return restful.HTML("%s used %d %s to buy %s gift for %d coins.",
-		e.Player().Name(), length, pluralize("card", length), e.Gift.Name(), e.Gift.Value)
+	return l.HTML("entry.buy-gift",
+		e.Player().Name(), length, l.Plural("card", length), l.Name(e.Gift.Name()), e.Gift.Value)
 }
 
 func (g *Game) validateBuyGift(c *gin.Context, cu *user.User) (ConCards, *GiftCard, int, error) {
@@ -82,46 +82,57 @@ func (g *Game) validateBuyGift(c *gin.Context, cu *user.User) (ConCards, *GiftCa
 		return nil, nil, 0, err
 	}
 
-	cds, err := g.getConCards(c, "buy-gift")
+	gv, err := g.getGiftValue(c, "buy-gift")
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
-	gv, err := g.getGiftValue(c, "buy-gift")
+	cp := g.CurrentPlayer()
+	gc := cp.GetGift(gv)
+	if gc == nil {
+		return nil, nil, 0, g.vError("error.dont-gift-value-buy", gv)
+	}
+
+	cds, err := g.getPayment(c, "buy-gift", gc.Cost(), payCoins)
 	if err != nil {
 		return nil, nil, 0, err
 	}
-
-	cp := g.CurrentPlayer()
 	cv := cds.Coins()
-	gc := cp.GetGift(gv)
 
 	switch {
-	case gc == nil:
-		return nil, nil, 0, sn.NewVError("You don't have a gift of value %d to buy.", gv)
 	case cv < gc.Cost():
-		return nil, nil, 0, sn.NewVError("You selected cards having %d total coins, but the %s gift costs %d coins.", cv, gc.Name(), gc.Value)
+		return nil, nil, 0, g.vError("error.insufficient-coins-gift", cv, gc.Name(), gc.Value)
 	default:
 		return cds, gc, cbs, nil
 	}
 }
 
 func (g *Game) EnableBuyGift(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.CurrentPlayer().canBuyGift()
+	return g.BuyGiftReasons(cu).None()
 }
 
-func (p *Player) canBuyGift() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction &&
-		p.hasEnoughCubesFor(BuyGiftSpace) && p.canAffordGift()
+// BuyGiftReasons returns why the user cu may not buy a gift.
+func (g *Game) BuyGiftReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, BuyGiftSpace)
+	if len(cp.GiftCardHand) == 0 {
+		r.require(false, "reason.no-gifts-to-buy")
+	} else {
+		r.coins(cp, cp.cheapestGiftCost())
+	}
+	return r.reasons
 }
 
-func (p *Player) canAffordGift() bool {
-	coins := p.ConCardHand.Coins()
-	for _, gc := range p.GiftCardHand {
-		if coins >= gc.Cost() {
-			return true
+// cheapestGiftCost returns the least cost of the gifts the player has yet to buy.
+func (p *Player) cheapestGiftCost() int {
+	cost := 0
+	for i, gc := range p.GiftCardHand {
+		if i == 0 || gc.Cost() < cost {
+			cost = gc.Cost()
 		}
 	}
-	return false
+	return cost
 }
@@ -9,7 +9,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -68,10 +67,11 @@ func (p *Player) newBuyJunksEntry(js int, played ConCards) *buyJunksEntry {
 }
 
 func (e *buyJunksEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
 	coins := e.Played.Coins()
-	return restful.HTML("%s spent %d Confucius %s having %d %s to buy %d %s.",
-		e.Player().Name(), length, pluralize("card", length), coins, pluralize("coin", coins), e.Junks, pluralize("junk", e.Junks))
+	return l.HTML("entry.buy-junks",
+		e.Player().Name(), length, l.Plural("card", length), coins, l.Plural("coin", coins), e.Junks, l.Plural("junk", e.Junks))
 }
 
 func (g *Game) validateBuyJunks(c *gin.Context, cu *user.User) (int, ConCards, int, error) {
@@ -83,40 +83,41 @@ func (g *Game) validateBuyJunks(c *gin.Context, cu *user.User) (int, ConCards, i
 		return 0, nil, 0, err
 	}
 
-	cds, err := g.getConCards(c, "buy-junks")
-	if err != nil {
-		return 0, nil, 0, err
-	}
-
 	js, err := strconv.Atoi(c.PostForm("junks"))
 	if err != nil {
 		return 0, nil, 0, fmt.Errorf(`Form value for "junks" is invalid.`)
 	}
 
+	if js > g.Junks {
+		return 0, nil, 0, g.vError("error.selected-more-junks-than-there-available")
+	}
+
 	cp := g.CurrentPlayer()
-	cv := cds.Coins()
 	cost := cp.junkCostFor(js)
+	cds, err := g.getPayment(c, "buy-junks", cost, payCoins)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	cv := cds.Coins()
 
 	switch {
 	case cv < cost:
-		return 0, nil, 0, sn.NewVError("You selected cards having %d total coins, but you need %d coins to buy the selected junks.", cv, cost)
-	case js > g.Junks:
-		return 0, nil, 0, sn.NewVError("You selected more junks than there are available in stock.")
+		return 0, nil, 0, g.vError("error.insufficient-coins-junks", cv, cost)
 	default:
 		return js, cds, cbs, err
 	}
 }
 
 func (g *Game) EnableBuyJunks(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.CurrentPlayer().canBuyJunks()
+	return g.BuyJunksReasons(cu).None()
 }
 
-func (p *Player) canBuyJunks() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction &&
-		p.hasEnoughCubesFor(JunksVoyageSpace) && p.canAffordJunk()
-}
-
-func (p *Player) canAffordJunk() bool {
-	return p.ConCardHand.Coins() >= p.junkCostFor(1)
+// BuyJunksReasons returns why the user cu may not buy junks.
+func (g *Game) BuyJunksReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, JunksVoyageSpace).coins(cp, cp.junkCostFor(1))
+	return r.reasons
 }
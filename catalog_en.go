@@ -0,0 +1,408 @@
+package confucius
+
+// englishCatalog provides the messages of the English locale, and the fallback of other locales.
+var englishCatalog = map[string]string{
+	// Words
+	"and":           "and",
+	"serial-comma":  ",",
+	"card.one":      "card",
+	"card.other":    "cards",
+	"coin.one":      "coin",
+	"coin.other":    "coins",
+	"junk.one":      "junk",
+	"junk.other":    "junks",
+	"license.one":   "license",
+	"license.other": "licenses",
+	"point.one":     "point",
+	"point.other":   "points",
+	"voyage.one":    "voyage",
+	"voyage.other":  "voyages",
+
+	// Text Board
+	"text.game":                 "Confucius #%d: %s",
+	"text.round-phase":          "Round %d, phase: %s",
+	"text.current-player":       "Current player: %s",
+	"text.wall":                 "Great Wall: %d sections",
+	"text.junks-in-stock":       "In stock: %d %s",
+	"text.titles":               "Titles",
+	"text.chief-minister":       "Chief Minister: %s",
+	"text.admiral":              "Admiral: %s",
+	"text.general":              "General: %s",
+	"text.avenger":              "Avenger of the Emperor: %s",
+	"text.decks":                "Decks",
+	"text.con-deck":             "Confucius deck: %d %s left",
+	"text.con-seen":             "%d-coin cards: %d seen, %d unseen, %d discarded",
+	"text.emperor-deck":         "Emperor deck: %d %s left; discarded: %s",
+	"text.officials-deck":       "Officials: %d left",
+	"text.none":                 "none",
+	"text.ministries":           "Ministries",
+	"text.ministry":             "%s: minister chit %d, secretary chit %d",
+	"text.resolved":             "(resolved)",
+	"text.in-progress":          "(resolution in progress)",
+	"text.minister":             "Minister: %s",
+	"text.secretary":            "Secretary: %s",
+	"text.official":             "Official of seniority %d, cost %d: %s",
+	"text.no-marker":            "no marker",
+	"text.marker":               "marker of %s",
+	"text.secured-marker":       "secured marker of %s",
+	"text.temp-marker":          "temporary marker of %s",
+	"text.candidates":           "Candidates",
+	"text.candidate":            "Candidate for %s",
+	"text.student":              "Student of %s with %s",
+	"text.candidates-remaining": "Candidates remaining in stack: %d",
+	"text.cards":                "%d %s",
+	"text.cards-coins":          "%d %s (%s) worth %d %s",
+	"text.foreign-lands":        "Foreign Lands",
+	"text.foreign-land":         "%s, invasion cost %d",
+	"text.box":                  "Box %d, %d %s: %s",
+	"text.box-award-card":       "Box %d, %d %s and an Emperor's Reward card: %s",
+	"text.distant-lands":        "Distant Lands",
+	"text.distant-land":         "%s: %s; %s",
+	"text.visited-by":           "visited by %s",
+	"text.not-visited":          "not yet visited",
+	"text.chit":                 "chit worth %d %s",
+	"text.no-chit":              "chit taken",
+	"text.player":               "%s: %d %s",
+	"text.action-cubes":         "Action cubes: %d",
+	"text.junks":                "Junks: %d, on voyage: %d",
+	"text.armies":               "Armies: %d unrecruited, %d recruited",
+	"text.confucius-cards":      "Confucius cards: %s",
+	"text.gift-hand":            "Gifts in hand: %s",
+	"text.gifts-received":       "Gifts received: %s",
+	"text.gift-from":            "%s from %s",
+	"text.emperor-cards":        "Emperor's Reward cards: %s",
+	"text.hidden-cards":         "%d hidden %s",
+
+	// Board Drawing
+	"board.candidates":      "Candidates",
+	"board.wall":            "Great Wall",
+	"board.bribe-secure":    "Bribe/Secure",
+	"board.nominate":        "Nominate",
+	"board.force":           "Force Exam",
+	"board.junks-voyage":    "Junks/Voyage",
+	"board.recruit-army":    "Recruit Army",
+	"board.buy-gift":        "Buy Gift",
+	"board.give-gift":       "Give Gift",
+	"board.petition":        "Petition",
+	"board.commercial":      "Commercial",
+	"board.tax-income":      "Tax Income",
+	"board.no-action":       "No Action",
+	"board.imperial-favour": "Imperial Favour",
+
+	// Since Last Turn
+	"summary.heading":            "Since your last turn",
+	"summary.game":               "Game",
+	"summary.score":              "Your score changed by %+d (%s).",
+	"summary.influence":          "Your bribed officials changed by %+d.",
+	"summary.owes-you":           "%s now owes you for a %s gift.",
+	"summary.no-longer-owes-you": "%s no longer owes you for a gift.",
+	"summary.you-owe":            "You now owe %s for a %s gift.",
+	"summary.you-no-longer-owe":  "You no longer owe %s for a gift.",
+
+	// Notices
+	"notice.finished-turn":      "%s finished turn.",
+	"notice.reset-turn":         "%s reset turn.",
+	"notice.game-created":       "%s created.",
+	"notice.tournament-created": "%s created.",
+
+	// Game Header
+	"header.advanced":         "Advanced",
+	"header.basic":            "Basic",
+	"header.without-variants": "%s without Variants",
+	"header.with-variants":    "%s with %s",
+	"header.progress":         "<div>Round: %d</div><div>Phase: %s</div>",
+
+	// Commit Points
+	"notice.rewind-stopped": "Your turn cannot be rewound past an action that revealed hidden information: %s",
+	"action.reveals":        "This action reveals hidden information and cannot be undone.",
+
+	// Unavailable Actions
+	"reason.already-nominated":        "you have already nominated a student",
+	"reason.already-passed":           "you have already passed",
+	"reason.already-performed-action": "you have already performed an action this turn",
+	"reason.basic-game":               "petitioning disabled in Basic Game",
+	"reason.candidate-full":           "the candidate already has two students",
+	"reason.commercial-taken":         "you have already taken a commercial action this round",
+	"reason.extra-action":             "not available while taking an extra action",
+	"reason.first-round":              "not available during the first round",
+	"reason.has-action-cubes":         "you must place your %d remaining action cubes before passing",
+	"reason.insufficient-coins":       "not enough coins (need %d, have %d)",
+	"reason.insufficient-licenses":    "not enough licenses (need %d, have %d)",
+	"reason.no-action-cubes":          "you have no action cubes",
+	"reason.no-armies":                "you have no armies left to recruit",
+	"reason.no-bought-gifts":          "you have no bought gifts",
+	"reason.no-bribable-official":     "no bribable official in an unresolved ministry",
+	"reason.no-candidate":             "there is no candidate",
+	"reason.no-con-cards":             "you have no Confucius cards",
+	"reason.no-emperor-cards":         "you have no Emperor's Reward cards",
+	"reason.no-foreign-land":          "there is no foreign land to invade",
+	"reason.no-gifts-to-buy":          "you have no gifts left to buy",
+	"reason.no-influence-to-transfer": "you have no marker on an official of an unresolved ministry",
+	"reason.no-junks":                 "you have no junks",
+	"reason.no-licenses":              "you have no licenses",
+	"reason.no-pending-voyage":        "you have no completed voyage awaiting a distant land",
+	"reason.no-petition-gift":         "you have no bought gift worth more than 1",
+	"reason.no-recruited-armies":      "you have no recruited armies",
+	"reason.no-securable-official":    "no official bearing your unsecured marker in an unresolved ministry",
+	"reason.no-transfer-awaited":      "you are not choosing to whom to transfer influence",
+	"reason.not-enough-cubes":         "not enough action cubes (need %d, have %d)",
+	"reason.not-your-turn":            "it is not your turn",
+	"reason.wrong-phase":              "not available during the %s phase",
+
+	// Ministry Resolution
+	"prompt.choose-transfer-target": "%[1]s must choose to whom to transfer influence in %[2]s ministry: %[3]s.",
+
+	// Gift Obligations
+	"gift.cancel-by-tutor":    "Tutor the student of %s with at least %d cards.",
+	"gift.cancel-by-transfer": "Transfer influence on an official to %s.",
+	"gift.cancel-by-temp":     "Temporarily transfer influence to %s.",
+	"gift.cancel-by-gift":     "Give %s a gift worth more than %s.",
+
+	// Stalled Games
+	"stall.no-current-player": "no player is to play during the %s phase",
+	"stall.no-legal-action":   "%s has no legal action during the %s phase",
+	"stall.no-turn-phase":     "the game stopped during the %s phase, in which no player plays",
+
+	// Log Entries
+	"entry.reshuffle":                   "The Confucius discard pile was shuffled to form a new deck of %d %s.",
+	"entry.bribe-official":              "%s bribed %s official with level %d seniority.",
+	"entry.bribe-official-paid":         "%s spent %d %s having %d coins to bribe %s official with level %d seniority.",
+	"entry.buy-gift":                    "%s used %d %s to buy %s gift for %d coins.",
+	"entry.buy-junks":                   "%s spent %d Confucius %s having %d %s to buy %d %s.",
+	"entry.choose-chief-minister":       "%s chose %s to be chief minister.",
+	"entry.commercial-income":           "%s spent %d Confucius %s having %d %s to receive %d cards of commercial income.",
+	"entry.count-gifts":                 "%s received %d action cubes for giving %d gifts and receiving %d gifts.",
+	"entry.discard":                     "%s discarded %d cards.",
+	"entry.take-cash":                   "%s played Emperor's Reward card to take four Confucius cards.",
+	"entry.take-gift":                   "%s used Emperor's Reward card to take %d value gift (%s).",
+	"entry.take-army":                   "%s played Emperor's Reward card to recruit an army.",
+	"entry.take-extra-action":           "%s played Emperor's Reward card to perform action without paying an action cube.",
+	"entry.avenge-emperor":              "%s used Emperor's Reward card and army to avenge emperor.",
+	"entry.take-bribery-reward":         "%s used Emperor's Reward card to place unsecured marker on %s official having %d seniority.",
+	"entry.take-bribery-reward-replace": "%s used Emperor's Reward card and %d Confucius %s having %d coins to replace unsecured marker of %s on %s official having %d seniority.",
+	"entry.score-chief-minister":        "%s awarded title of Chief Minister and %d %s.",
+	"entry.score-admiral":               "%s awarded title of Admiral and %d %s.",
+	"entry.score-general":               "%s awarded title of General and %d %s.",
+	"entry.announce-winners":            "Congratulations to: %s.",
+	"entry.examination-won":             "%s won the Imperial Examination.",
+	"entry.examination-student-coins":   "The student of %s received %d coins on %d %s.",
+	"entry.examination-uncontested":     "%s won the Imperial Examination uncontested.",
+	"entry.force-exam":                  "%s spent %d %s having %d coins to force an examination.",
+	"entry.give-gift":                   "%s gave value %d gift (%s) to %s.",
+	"entry.give-gift-cancel":            "%s gave value %d gift (%s) to %s and canceled gift from %s.",
+	"entry.invade-land":                 "%s invaded the %d VP box of %s.",
+	"entry.invade-land-paid":            "%s spent %d Confucius cards having a value of %d coins to invade the %d VP box of %s.",
+	"entry.invasion-succeeded":          "The invasion of %s succeeded.",
+	"entry.invasion-failed":             "The invasion of %s failed.",
+	"entry.invasion-points":             "%s received %d points.",
+	"entry.invasion-reward-card":        "%s awarded an Emperor's Reward card.",
+	"entry.ministry-resolved":           "%s Ministry Resolved",
+	"entry.minister-awarded":            "%s awarded Minister position and %d points",
+	"entry.no-minister":                 "No one awarded Minister position",
+	"entry.secretary-awarded":           "%s awarded Secretary position and %d points",
+	"entry.no-secretary":                "No one awarded Secretary position",
+	"entry.no-action":                   "%s performed no action.",
+	"entry.nominate-student":            "%s nominated a student.",
+	"entry.nominate-student-paid":       "%s spent %d %s having %d coins to nominate student.",
+	"entry.pass":                        "%s passed.",
+	"entry.auto-pass":                   "System auto passed for %s.",
+	"entry.move-junks":                  "%s used value 2 gift (Tile) to petition Emperor and move %d %s from %s to %s.",
+	"entry.replace-student":             "%s used value 3 gift (Vase) to petition Emperor and replace student of %s with own student.",
+	"entry.swap-officials":              "%s used value 4 gift (Coat) to swap %s official with %d seniority with %s official with %d seniority.",
+	"entry.redeploy-army":               "%s used value 5 gift (Necklace) to redeploy army from %d point box of %s to %d point box of %s.",
+	"entry.replace-influence":           "%s used value 6 gift (Junk) to replace unsecured marker of %s on %s official with %d seniority with a secured marker of %s.",
+	"entry.place-student-unable":        "%s was unable to place student.",
+	"entry.place-student":               "%s placed student in seniority spot %d of %s ministry.",
+	"entry.place-student-replace":       "%s placed student in seniority spot %d of %s ministry replacing official of %s.",
+	"entry.recruit-army":                "%s spent %d Confucius cards having %d licenses to recruit army.",
+	"entry.secure-official":             "%s spent %d %s having %d coins to secure %s official having level %d seniority.",
+	"entry.court-card":                  "%s drew court card %s.",
+	"entry.court-card-not-taken":        "%s drew court card %s, but could not take the action.",
+	"entry.solo-won":                    "%s reached the target of %d points and defeated the Emperor's court.",
+	"entry.solo-lost":                   "%s fell short of the target of %d points and was defeated by the Emperor's court.",
+	"entry.start-voyage-paid":           "%s spent %d Confucius %s having %d %s to send %d %s on a voyage.",
+	"entry.start-voyage":                "%s sent %d %s on a voyage.",
+	"entry.voyage-completed-reward":     "%s completed voyage to %s, scored %d points, and received an Emperor Reward card.",
+	"entry.voyage-completed":            "%s completed voyage to %s, scored %d points, and did not receive an Emperor Reward card.",
+	"entry.tax-income":                  "%s received two Confucius cards of tax income.",
+	"entry.temp-transfer":               "%s temporarily transfered influence in %s ministry to %s.",
+	"entry.temp-transfer-gift":          "%s temporarily transfered influence in %s ministry to %s, and removed gift %s from play.",
+	"entry.auto-temp-transfer":          "System auto-transfered influence in %s ministry temporarily from %s to %s.",
+	"entry.auto-temp-transfer-gift":     "System auto-transfered influence in %s ministry temporarily from %s to %s, and removed gift %s from play.",
+	"entry.decision-default":            "%s did not decide in time, so the choice was made by default.",
+	"entry.advance-stalled-turn":        "An administrator advanced the stalled turn of %s.",
+	"entry.advance-stalled-game":        "An administrator advanced the stalled game.",
+	"entry.transfer-influence":          "%s transferred influence on %s official with level %d seniority to %s.",
+	"entry.transfer-influence-gift":     "%s transferred influence on %s official with level %d seniority to %s, and removed %s gift of %s from game.",
+	"entry.auto-tutor-student":          "%s auto-spent %d %s to tutor student of %s.",
+	"entry.tutor-student":               "%s spent %d %s to tutor student of %s.",
+	"entry.tutor-student-cancel":        "%s spent %d %s to tutor student of %s and canceled gift received from %s.",
+	"entry.tutor-student-no-cards":      "%s has no cards to tutor a student.",
+	"entry.neutral-bribe":               "%s bribed official with seniority %d in the %s ministry.",
+	"entry.neutral-tutor":               "%s tutored its student with %d %s drawn from the deck providing %d coins.",
+	"entry.voyage-default-land":         "The distant land was chosen automatically.",
+	"entry.voyages-completed":           "%s completed %d %s and will choose the distant lands.",
+
+	// Validation Errors
+	"error.already-nominated-student":                         "You already have a nominated student.",
+	"error.already-taken-commercial-income-action-round":      "You have already taken the commercial income action this round.",
+	"error.been-muted-game":                                   "You have been muted in this game.",
+	"error.can-not-select-more-than-once":                     "You can not select %s more than once.",
+	"error.cannot-advance-phase":                              "The game cannot be advanced automatically during the %s phase.",
+	"error.cannot-appoint-yourself-chief-minister":            "You cannot appoint yourself chief minister.",
+	"error.cannot-auto-pay":                                   "You cannot pay %d %s from the cards in your hand without playing the cards to keep.",
+	"error.cannot-choose-chief-minister-during-phase":         "You cannot choose a chief minister during the %s phase.",
+	"error.cannot-discard-cards-during-phase":                 "You cannot discard cards during the %s phase.",
+	"error.cannot-force-examination-during-round":             "You cannot force an examination during round %d.",
+	"error.cannot-nominate-student-during-round":              "You cannot nominate a student during round %d.",
+	"error.cannot-pay-tutor-student-during-phase":             "You cannot pay to tutor a student during the %s phase.",
+	"error.cannot-perform-action-during-phase":                "You cannot perform a %q action during the %s phase.",
+	"error.cannot-perform-player-action-after-passing":        "You cannot perform a player action after passing.",
+	"error.cannot-petition-emperor-basic-game":                "You cannot petition the emperor in the basic game.",
+	"error.cannot-place-student-ministry":                     "You cannot place a student in ministry %s.",
+	"error.cannot-place-student-ministry-during-phase":        "You cannot place a student in a ministry during the %s phase.",
+	"error.cannot-place-student-seniority-spot-ministry":      "You cannot place a student in seniority spot %d of ministry %s.",
+	"error.cannot-temporarily-transfer-influence-ministry":    "You cannot temporarily transfer influence in %s ministry to %s.",
+	"error.cannot-transfer-influence-during-phase":            "You cannot transfer influence during the %s phase.",
+	"error.cant-bribe-official-already-marker":                "You can't bribe an official that already has a marker.",
+	"error.cant-give-yourself-gift":                           "You can't give yourself a gift.",
+	"error.cant-redeploy-from-resolved-land":                  "You can't redeploy an army from a resolved foreign land tile.",
+	"error.cant-redeploy-to-resolved-land":                    "You can't redeploy an army to a resolved foreign land tile.",
+	"error.cant-report-own-message":                           "You can't report your own message.",
+	"error.cant-transfer-influence-resolved-ministry":         "You can't transfer influence in a resolved ministry.",
+	"error.channel-requires-at-least-two-players":             "A channel requires at least two players.",
+	"error.did-not-play-correct-emperors-reward":              "You did not play the correct emperor's reward card for the selected action.",
+	"error.did-not-select-marker-another-player":              "You did not select a marker of another player.",
+	"error.did-not-select-one-officials-swap":                 "You did not select one of your officials to swap.",
+	"error.distant-land-already-visited":                      "You must select a distant land you have yet to visit.",
+	"error.distant-land-chits-must-drawn":                     "The distant land chits must be drawn from %v.",
+	"error.dont-army-selected-box":                            "You don't have an army in the selected box.",
+	"error.dont-gift-value-buy":                               "You don't have a gift of value %d to buy.",
+	"error.dont-gift-value-give":                              "You don't have a gift of value %d to give.",
+	"error.dont-influence-over-official-having-seniority":     "You don't have influence over the official having seniority level %d in the %s ministry.",
+	"error.dont-selected-emperors-reward-card":                "You don't have the selected Emperor's Reward card.",
+	"error.dont-value-2-tile-gift-petition":                   "You don't have a value 2 (Tile) gift with which to petition the Emperor.",
+	"error.dont-value-3-vase-gift-petition":                   "You don't have a value 3 (Vase) gift with which to petition the Emperor.",
+	"error.dont-value-4-coat-gift-petition":                   "You don't have a value 4 (Coat) gift with which to petition the Emperor.",
+	"error.dont-value-5-necklace-gift-petition":               "You don't have a value 5 (Necklace) gift with which to petition the Emperor.",
+	"error.dont-value-6-junk-gift-petition":                   "You don't have a value 6 (Junk) gift with which to petition the Emperor.",
+	"error.each-table-must-seat-at-least":                     "Each table must seat at least 2 players.",
+	"error.game-not-found":                                    "Game not found.",
+	"error.game-not-stalled":                                  "The game is not stalled.",
+	"error.game-state-changed":                                "Game state changed unexpectedly.  Try again.",
+	"error.gift-obligation-prevents-bribing-another-official": "You have a gift obligation to %s that prevents you from bribing another official in the %s ministry.",
+	"error.improper-phase-finishing-turn":                     "Improper Phase for finishing turn.",
+	"error.insufficient-coins-bribe":                          "You selected cards having %d total coins, but you need %d coins to bribe the selected official.",
+	"error.insufficient-coins-force-exam":                     "You selected cards having %d total coins, but you need 2 coins to force an examination.",
+	"error.insufficient-coins-gift":                           "You selected cards having %d total coins, but the %s gift costs %d coins.",
+	"error.insufficient-coins-invade":                         "You selected cards having %d total coins, but you need %d coins to invade the selected land.",
+	"error.insufficient-coins-junks":                          "You selected cards having %d total coins, but you need %d coins to buy the selected junks.",
+	"error.insufficient-coins-nominate":                       "You selected cards having %d total coins, but you need 2 coins to nominate a student.",
+	"error.insufficient-coins-secure":                         "You selected cards having %d total coins, but you need %d coins to secure the selected official.",
+	"error.insufficient-licenses-recruit":                     "You selected cards having %d total licenses, but you need %d licenses to recruit and army.",
+	"error.insufficient-licenses-voyage":                      "You selected cards having %d total licenses, but you need %d licenses to start a voyage with %d junks.",
+	"error.invalid-action":                                    "%q is an invalid action.",
+	"error.invalid-box-index":                                 "Invalid value received for %q box: boxIndex: %d, Boxes length: %d.",
+	"error.invalid-box-value":                                 "Invalid value received for %q box.",
+	"error.invalid-format-ministry-seniority-param":           "Invalid format for ministry/seniority param.",
+	"error.invalid-ministry-provided":                         "Invalid Ministry Provided.",
+	"error.invalid-official-selected":                         "Invalid official selected.",
+	"error.invalid-official-seniority-provided":               "Invalid Official Seniority Provided.",
+	"error.invalid-tile-value":                                "Invalid value received for %q tile.",
+	"error.invalid-value-coin-1-cards-received":               "Invalid value for Coin 1 cards received.",
+	"error.invalid-value-coin-2-cards-received":               "Invalid value for Coin 2 cards received.",
+	"error.invalid-value-coin-3-cards-received":               "Invalid value for Coin 3 cards received.",
+	"error.invalid-value-junks-received":                      "Invalid value for junks received.",
+	"error.may-only-change-own-messages":                      "You may only change your own messages.",
+	"error.may-only-pay-up-4-coins":                           "You may only pay up to 4 coins. You paid %d coins.",
+	"error.may-post-no-more-than-messages":                    "You may post no more than %d messages per minute.",
+	"error.message-already-been-removed":                      "The message has already been removed.",
+	"error.message-been-deleted":                              "The message has been deleted.",
+	"error.message-been-hidden-by-moderator":                  "The message has been hidden by a moderator.",
+	"error.messages-limited-characters":                       "Messages are limited to %d characters.",
+	"error.messages-may-only-changed-within-being":            "Messages may only be changed within %v of being posted.",
+	"error.ministry-chits-must-drawn":                         "The ministry chits must be drawn from %v.",
+	"error.must-at-least-action-cubes-perform":                "You must have at least %d Action Cubes to perform this action.",
+	"error.must-be-logged-in":                                 "You must be logged in.",
+	"error.must-be-logged-in-create-tournament":               "You must be logged in to create a tournament.",
+	"error.must-discard-down-4-cards-discarded":               "You must discard down to 4 cards.  You have discarded to %d cards.",
+	"error.must-enter-message":                                "You must enter a message.",
+	"error.must-give-gift-greater-than-equal":                 "You must give a gift that is greater than or equal to the gift the player gave you.",
+	"error.must-give-gift-greater-than-present":               "You must give a gift that is greater than your present gift to the player.",
+	"error.must-marker-official-before-securing-it":           "You must have a marker on the official before securing it.",
+	"error.must-play-at-least-one-confucius":                  "You must play at least one Confucius Card.",
+	"error.must-provide-2-chits-each-ministries":              "You must provide 2 chits for each of the %d ministries.",
+	"error.must-provide-chit-each-distant-lands":              "You must provide a chit for each of the %d distant lands.",
+	"error.must-select-box-redeploy-from":                     "You must select a land box from which to redeploy an army.",
+	"error.must-select-box-redeploy-to":                       "You must select a land box to which to redeploy an army.",
+	"error.must-select-distant-land":                          "You must select a distant land.",
+	"error.must-select-emperors-reward-card":                  "You must select an Emperor's Reward card.",
+	"error.must-select-exactly-3-foreign-lands":               "You must select exactly 3 foreign lands.",
+	"error.must-select-gift-card":                             "You must select an gift card.",
+	"error.must-select-land-redeploy-army":                    "You must select a land from which to redeploy an army.",
+	"error.must-select-official":                              "You must select an official.",
+	"error.must-select-official-doesnt-marker":                "You must select an official that doesn't have your marker.",
+	"error.must-select-official-doesnt-secured-marker":        "You must select an official that doesn't have a secured marker.",
+	"error.must-select-official-marker":                       "You must select an official with a marker.",
+	"error.must-select-official-without-secured-marker":       "You must select an official without a secured marker.",
+	"error.must-select-player":                                "You must select a player.",
+	"error.must-select-player-move-junks-from":                "You must select a player from which to move junks.",
+	"error.must-select-player-move-junks-to":                  "You must select a player to which to move junks.",
+	"error.must-select-valid-ministry-selected-card":          "You must select a valid ministry for the selected card.",
+	"error.must-use-all-action-cubes-before":                  "You must use all of your action cubes before passing.",
+	"error.no-armies-recruit":                                 "You have no armies to recruit.",
+	"error.no-junks-move":                                     "%s has no junks to move.",
+	"error.no-ministry-resolution-progress":                   "No ministry resolution in progress.",
+	"error.no-pending-decision":                               "No decision is awaited.",
+	"error.no-pending-voyage":                                 "You have no completed voyage awaiting a distant land.",
+	"error.no-recruited-armies-avenge-emperor":                "You have no recruited armies with which to avenge the Emperor.",
+	"error.no-recruited-armies-invasion":                      "You have no recruited armies for an invasion.",
+	"error.no-transfer-awaited":                               "You are not choosing to whom to transfer influence.",
+	"error.not-valid-action":                                  "%v is not a valid action.",
+	"error.only-admins-may-create-tournaments":                "Only admins may create tournaments.",
+	"error.only-current-chief-minister-may-select":            "Only the current chief minister may select the succeeding chief minister.",
+	"error.only-current-player-may-choose-chief":              "Only the current player may choose a chief minister.",
+	"error.only-current-player-may-discard-cards":             "Only a current player may discard cards.",
+	"error.only-current-player-may-finish-turn":               "Only the current player may finish a turn.",
+	"error.only-current-player-may-pay-tutor":                 "Only the current player may pay to tutor a student.",
+	"error.only-current-player-may-perform-action":            "Only the current player may perform this action.",
+	"error.only-current-player-may-perform-player":            "Only the current player may perform the player action %q.",
+	"error.only-current-player-may-place-student":             "Only the current player may place a student in a ministry.",
+	"error.only-players-may-open-channel":                     "Only players of the game may open a channel.",
+	"error.only-players-may-report-messages":                  "Only players of the game may report messages.",
+	"error.overpayment":                                       "You selected cards having %d total %s, but the cost is %d; you would still pay it without a card worth %d %s, so keep that card instead.",
+	"error.player-form-value-not-found":                       "Player form value %q not found.",
+	"error.player-may-only-enter-tournament-once":             "A player may only enter a tournament once.",
+	"error.provided-incorrect-player":                         "You provided an incorrect player.",
+	"error.received-invalid-foreign-land":                     "Received invalid foreign land.",
+	"error.received-invalid-message":                          "Received invalid message.",
+	"error.received-invalid-player":                           "Received invalid player.",
+	"error.received-invalid-report":                           "Received invalid report.",
+	"error.received-invalid-tie-breaker":                      "Received invalid tie-breaker.",
+	"error.received-invalid-tournament-format":                "Received invalid tournament format.",
+	"error.received-invalid-user":                             "Received invalid user.",
+	"error.recipient-not-found":                               "Recipient not found.",
+	"error.save-failed":                                       "The game could not be saved: %s",
+	"error.selected-cards-one-coin-but-only":                  "You selected %d cards with one coin, but only have %d of such cards.",
+	"error.selected-cards-three-coins-but-only":               "You selected %d cards with three coins, but only have %d of such cards.",
+	"error.selected-cards-two-coins-but-only":                 "You selected %d cards with two coins, but only have %d of such cards.",
+	"error.selected-gift-card-not-available":                  "Selected gift card is not available.",
+	"error.selected-junks-voyage-buy-only-junks":              "You have selected %d junks for the voyage, buy only have %d junks available.",
+	"error.selected-land-box-already-army":                    "The selected land box already has an army.",
+	"error.selected-more-junks-than-there-available":          "You selected more junks than there are available in stock.",
+	"error.selected-official-another-player-higher-seniority": "You selected an official of another player with a higher seniority.",
+	"error.selected-official-resolved-ministry":               "You selected an official from a resolved ministry.",
+	"error.selected-official-without-marker":                  "You selected an official without a marker.",
+	"error.selected-player-does-not-student":                  "Selected player does not have a student.",
+	"error.selected-player-not-found":                         "Selected player not found.",
+	"error.selected-secured-official":                         "You selected a secured official.",
+	"error.selected-space-without-official":                   "You selected a space without an official.",
+	"error.tables-must-seat-2-5-players":                      "Tables must seat from 2 to 5 players.",
+	"error.there-already-two-students":                        "There are already two students.",
+	"error.there-no-distant-lands-can-voyage":                 "There are no distant lands to which you can voyage.",
+	"error.there-no-student-replace":                          "There is no student to replace.",
+	"error.tournament-must-1-rounds":                          "A tournament must have from 1 to %d rounds.",
+	"error.tournament-requires-at-least-2-players":            "A tournament requires at least 2 players.",
+	"error.variant-requires-players":                          "The %s variant requires %d players.",
+	"error.yet-perform-action":                                "%s has yet to perform an action.",
+}
@@ -0,0 +1,467 @@
+package confucius
+
+// frenchCatalog provides the messages of the French locale.
+var frenchCatalog = map[string]string{
+	// Words
+	"and":           "et",
+	"serial-comma":  "",
+	"card.one":      "carte",
+	"card.other":    "cartes",
+	"coin.one":      "pièce",
+	"coin.other":    "pièces",
+	"junk.one":      "jonque",
+	"junk.other":    "jonques",
+	"license.one":   "licence",
+	"license.other": "licences",
+	"point.one":     "point",
+	"point.other":   "points",
+	"voyage.one":    "voyage",
+	"voyage.other":  "voyages",
+
+	// Names
+	"name.Hanging":                    "Tenture",
+	"name.Tile":                       "Tuile",
+	"name.Vase":                       "Vase",
+	"name.Coat":                       "Manteau",
+	"name.Necklace":                   "Collier",
+	"name.Junk":                       "Jonque",
+	"name.The Spice Islands":          "les îles aux épices",
+	"name.India":                      "l'Inde",
+	"name.Arabia":                     "l'Arabie",
+	"name.Africa":                     "l'Afrique",
+	"name.The Americas":               "les Amériques",
+	"name.Annam":                      "Annam",
+	"name.Yunnan":                     "Yunnan",
+	"name.Mongolia":                   "Mongolie",
+	"name.Korea":                      "Corée",
+	"name.Manchuria":                  "Mandchourie",
+	"name.Bribe Official":             "Corrompre un fonctionnaire",
+	"name.Nominate Student":           "Présenter un étudiant",
+	"name.Invade Land":                "Envahir une terre",
+	"name.Start Voyage":               "Partir en voyage",
+	"name.Cash":                       "Argent",
+	"name.Gift":                       "Cadeau",
+	"name.Extra Action":               "Action supplémentaire",
+	"name.Bribery in Bingbu Ministry": "Corruption au ministère Bingbu",
+	"name.Bribery in Hubu Ministry":   "Corruption au ministère Hubu",
+	"name.Bribery in Gongbu Ministry": "Corruption au ministère Gongbu",
+	"name.Bribery in Any Ministry":    "Corruption dans un ministère au choix",
+	"name.Emperor Insulted":           "Empereur insulté",
+	"name.Recruit an Army":            "Recruter une armée",
+	"name.None":                       "Aucune",
+	"name.Setup":                      "Mise en place",
+	"name.Start Game":                 "Début de partie",
+	"name.Count Gifts":                "Décompte des cadeaux",
+	"name.Choose Chief Minister":      "Choix du Premier ministre",
+	"name.Actions":                    "Actions",
+	"name.Imperial Favour":            "Faveur impériale",
+	"name.Build Wall":                 "Construction de la muraille",
+	"name.Imperial Examination":       "Examen impérial",
+	"name.Examination Resolution":     "Résolution de l'examen",
+	"name.Ministry Resolution":        "Résolution des ministères",
+	"name.Invasion":                   "Invasion",
+	"name.End Of Round":               "Fin de manche",
+	"name.Discard":                    "Défausse",
+	"name.Final Ministry Resolution":  "Résolution finale des ministères",
+	"name.End Game Scoring":           "Décompte final",
+	"name.Award Chief Minister":       "Prix du Premier ministre",
+	"name.Award Admiral":              "Prix de l'amiral",
+	"name.Award General":              "Prix du général",
+	"name.Announce Winners":           "Annonce des vainqueurs",
+	"name.Game Over":                  "Partie terminée",
+	"name.Admiral Variant":            "Variante de l'amiral",
+	"name.Short Wall":                 "Muraille courte",
+	"name.Prestigious Titles":         "Titres prestigieux",
+	"name.Shipwrights":                "Charpentiers de marine",
+	"name.Generous Treasury":          "Trésor généreux",
+	"name.Two Player Rules":           "Règles à deux joueurs",
+	"name.Solo":                       "Solo",
+
+	// Text Board
+	"text.game":                 "Confucius n° %d : %s",
+	"text.round-phase":          "Manche %d, phase : %s",
+	"text.current-player":       "Joueur actif : %s",
+	"text.wall":                 "Grande Muraille : %d sections",
+	"text.junks-in-stock":       "En réserve : %d %s",
+	"text.titles":               "Titres",
+	"text.chief-minister":       "Premier Ministre : %s",
+	"text.admiral":              "Amiral : %s",
+	"text.general":              "Général : %s",
+	"text.avenger":              "Vengeur de l'Empereur : %s",
+	"text.decks":                "Pioches",
+	"text.con-deck":             "Pioche Confucius : %d %s restantes",
+	"text.con-seen":             "Cartes à %d pièces : %d vues, %d non vues, %d défaussées",
+	"text.emperor-deck":         "Pioche de l'Empereur : %d %s restantes ; défaussées : %s",
+	"text.officials-deck":       "Fonctionnaires : %d restants",
+	"text.none":                 "aucun",
+	"text.ministries":           "Ministères",
+	"text.ministry":             "%s : jeton de ministre %d, jeton de secrétaire %d",
+	"text.resolved":             "(résolu)",
+	"text.in-progress":          "(résolution en cours)",
+	"text.minister":             "Ministre : %s",
+	"text.secretary":            "Secrétaire : %s",
+	"text.official":             "Fonctionnaire de rang %d, coût %d : %s",
+	"text.no-marker":            "aucun marqueur",
+	"text.marker":               "marqueur de %s",
+	"text.secured-marker":       "marqueur protégé de %s",
+	"text.temp-marker":          "marqueur temporaire de %s",
+	"text.candidates":           "Candidats",
+	"text.candidate":            "Candidat pour %s",
+	"text.student":              "Étudiant de %s avec %s",
+	"text.candidates-remaining": "Candidats restant dans la pile : %d",
+	"text.cards":                "%d %s",
+	"text.cards-coins":          "%d %s (%s) valant %d %s",
+	"text.foreign-lands":        "Terres étrangères",
+	"text.foreign-land":         "%s, coût d'invasion %d",
+	"text.box":                  "Case %d, %d %s : %s",
+	"text.box-award-card":       "Case %d, %d %s et une carte Récompense de l'Empereur : %s",
+	"text.distant-lands":        "Terres lointaines",
+	"text.distant-land":         "%s : %s ; %s",
+	"text.visited-by":           "visitée par %s",
+	"text.not-visited":          "pas encore visitée",
+	"text.chit":                 "jeton valant %d %s",
+	"text.no-chit":              "jeton pris",
+	"text.player":               "%s : %d %s",
+	"text.action-cubes":         "Cubes d'action : %d",
+	"text.junks":                "Jonques : %d, en voyage : %d",
+	"text.armies":               "Armées : %d non recrutées, %d recrutées",
+	"text.confucius-cards":      "Cartes Confucius : %s",
+	"text.gift-hand":            "Cadeaux en main : %s",
+	"text.gifts-received":       "Cadeaux reçus : %s",
+	"text.gift-from":            "%s de %s",
+	"text.emperor-cards":        "Cartes Récompense de l'Empereur : %s",
+	"text.hidden-cards":         "%d %s cachées",
+
+	// Board Drawing
+	"board.candidates":      "Candidats",
+	"board.wall":            "Grande Muraille",
+	"board.bribe-secure":    "Corrompre/Sécuriser",
+	"board.nominate":        "Nommer",
+	"board.force":           "Forcer l'examen",
+	"board.junks-voyage":    "Jonques/Voyage",
+	"board.recruit-army":    "Recruter une armée",
+	"board.buy-gift":        "Acheter un cadeau",
+	"board.give-gift":       "Offrir un cadeau",
+	"board.petition":        "Pétition",
+	"board.commercial":      "Commerce",
+	"board.tax-income":      "Impôts",
+	"board.no-action":       "Aucune action",
+	"board.imperial-favour": "Faveur impériale",
+
+	// Since Last Turn
+	"summary.heading":            "Depuis votre dernier tour",
+	"summary.game":               "Partie",
+	"summary.score":              "Votre score a changé de %+d (%s).",
+	"summary.influence":          "Vos fonctionnaires corrompus ont changé de %+d.",
+	"summary.owes-you":           "%s vous est désormais redevable d'un cadeau %s.",
+	"summary.no-longer-owes-you": "%s ne vous est plus redevable d'un cadeau.",
+	"summary.you-owe":            "Vous êtes désormais redevable à %s d'un cadeau %s.",
+	"summary.you-no-longer-owe":  "Vous n'êtes plus redevable à %s d'un cadeau.",
+
+	// Notices
+	"notice.finished-turn":      "%s a terminé son tour.",
+	"notice.reset-turn":         "%s a réinitialisé son tour.",
+	"notice.game-created":       "Partie %s créée.",
+	"notice.tournament-created": "Tournoi %s créé.",
+
+	// Game Header
+	"header.advanced":         "Avancé",
+	"header.basic":            "Base",
+	"header.without-variants": "%s sans variante",
+	"header.with-variants":    "%s avec %s",
+	"header.progress":         "<div>Manche : %d</div><div>Phase : %s</div>",
+
+	// Commit Points
+	"notice.rewind-stopped": "Votre tour ne peut pas être annulé au-delà d'une action ayant révélé des informations cachées : %s",
+	"action.reveals":        "Cette action révèle des informations cachées et ne peut pas être annulée.",
+
+	// Unavailable Actions
+	"reason.already-nominated":        "vous avez déjà présenté un étudiant",
+	"reason.already-passed":           "vous avez déjà passé",
+	"reason.already-performed-action": "vous avez déjà effectué une action ce tour-ci",
+	"reason.basic-game":               "pétition désactivée dans le jeu de base",
+	"reason.candidate-full":           "le candidat a déjà deux étudiants",
+	"reason.commercial-taken":         "vous avez déjà effectué une action commerciale cette manche",
+	"reason.extra-action":             "indisponible pendant une action supplémentaire",
+	"reason.first-round":              "indisponible pendant la première manche",
+	"reason.has-action-cubes":         "vous devez placer vos %d cubes d'action restants avant de passer",
+	"reason.insufficient-coins":       "pas assez de pièces (il en faut %d, vous en avez %d)",
+	"reason.insufficient-licenses":    "pas assez de licences (il en faut %d, vous en avez %d)",
+	"reason.no-action-cubes":          "vous n'avez aucun cube d'action",
+	"reason.no-armies":                "vous n'avez plus d'armée à recruter",
+	"reason.no-bought-gifts":          "vous n'avez aucun cadeau acheté",
+	"reason.no-bribable-official":     "aucun fonctionnaire corruptible dans un ministère non résolu",
+	"reason.no-candidate":             "il n'y a pas de candidat",
+	"reason.no-con-cards":             "vous n'avez aucune carte Confucius",
+	"reason.no-emperor-cards":         "vous n'avez aucune carte Récompense de l'Empereur",
+	"reason.no-foreign-land":          "il n'y a aucune terre étrangère à envahir",
+	"reason.no-gifts-to-buy":          "vous n'avez plus de cadeau à acheter",
+	"reason.no-influence-to-transfer": "vous n'avez aucun marqueur sur un fonctionnaire d'un ministère non résolu",
+	"reason.no-junks":                 "vous n'avez aucune jonque",
+	"reason.no-licenses":              "vous n'avez aucune licence",
+	"reason.no-pending-voyage":        "vous n'avez aucun voyage terminé en attente d'une terre lointaine",
+	"reason.no-petition-gift":         "vous n'avez aucun cadeau acheté valant plus de 1",
+	"reason.no-recruited-armies":      "vous n'avez aucune armée recrutée",
+	"reason.no-securable-official":    "aucun fonctionnaire portant votre marqueur non sécurisé dans un ministère non résolu",
+	"reason.no-transfer-awaited":      "vous n'avez pas à choisir à qui transférer votre influence",
+	"reason.not-enough-cubes":         "pas assez de cubes d'action (il en faut %d, vous en avez %d)",
+	"reason.not-your-turn":            "ce n'est pas votre tour",
+	"reason.wrong-phase":              "indisponible pendant la phase %s",
+
+	// Ministry Resolution
+	"prompt.choose-transfer-target": "%[1]s doit choisir à qui transférer son influence dans le ministère %[2]s : %[3]s.",
+
+	// Gift Obligations
+	"gift.cancel-by-tutor":    "Instruire l'étudiant de %s avec au moins %d cartes.",
+	"gift.cancel-by-transfer": "Transférer son influence sur un fonctionnaire à %s.",
+	"gift.cancel-by-temp":     "Transférer temporairement son influence à %s.",
+	"gift.cancel-by-gift":     "Offrir à %s un cadeau valant plus que %s.",
+
+	// Stalled Games
+	"stall.no-current-player": "aucun joueur ne doit jouer pendant la phase %s",
+	"stall.no-legal-action":   "%s n'a aucune action autorisée pendant la phase %s",
+	"stall.no-turn-phase":     "la partie s'est arrêtée pendant la phase %s, où aucun joueur ne joue",
+
+	// Log Entries
+	"entry.reshuffle":                   "La défausse des cartes Confucius a été mélangée pour former une nouvelle pioche de %d %s.",
+	"entry.bribe-official":              "%s a corrompu le fonctionnaire de rang %[3]d du ministère %[2]s.",
+	"entry.bribe-official-paid":         "%s a dépensé %d %s valant %d pièces pour corrompre le fonctionnaire de rang %[6]d du ministère %[5]s.",
+	"entry.buy-gift":                    "%s a utilisé %d %s pour acheter le cadeau %s pour %d pièces.",
+	"entry.buy-junks":                   "%s a dépensé %d %s Confucius valant %d %s pour acheter %d %s.",
+	"entry.choose-chief-minister":       "%s a choisi %s comme premier ministre.",
+	"entry.commercial-income":           "%s a dépensé %d %s Confucius valant %d %s pour recevoir %d cartes de revenu commercial.",
+	"entry.count-gifts":                 "%s a reçu %d cubes d'action pour avoir offert %d cadeaux et en avoir reçu %d.",
+	"entry.discard":                     "%s a défaussé %d cartes.",
+	"entry.take-cash":                   "%s a joué une carte Récompense de l'Empereur pour prendre quatre cartes Confucius.",
+	"entry.take-gift":                   "%s a utilisé une carte Récompense de l'Empereur pour prendre un cadeau de valeur %d (%s).",
+	"entry.take-army":                   "%s a joué une carte Récompense de l'Empereur pour recruter une armée.",
+	"entry.take-extra-action":           "%s a joué une carte Récompense de l'Empereur pour effectuer une action sans payer de cube d'action.",
+	"entry.avenge-emperor":              "%s a utilisé une carte Récompense de l'Empereur et une armée pour venger l'empereur.",
+	"entry.take-bribery-reward":         "%s a utilisé une carte Récompense de l'Empereur pour placer un marqueur non protégé sur le fonctionnaire de rang %[3]d du ministère %[2]s.",
+	"entry.take-bribery-reward-replace": "%s a utilisé une carte Récompense de l'Empereur et %d %s Confucius valant %d pièces pour remplacer le marqueur non protégé de %s sur le fonctionnaire de rang %[7]d du ministère %[6]s.",
+	"entry.score-chief-minister":        "%s reçoit le titre de Premier Ministre et %d %s.",
+	"entry.score-admiral":               "%s reçoit le titre d'Amiral et %d %s.",
+	"entry.score-general":               "%s reçoit le titre de Général et %d %s.",
+	"entry.announce-winners":            "Félicitations à : %s.",
+	"entry.examination-won":             "%s a remporté l'Examen Impérial.",
+	"entry.examination-student-coins":   "L'étudiant de %s a reçu %d pièces sur %d %s.",
+	"entry.examination-uncontested":     "%s a remporté l'Examen Impérial sans opposition.",
+	"entry.force-exam":                  "%s a dépensé %d %s valant %d pièces pour forcer un examen.",
+	"entry.give-gift":                   "%s a offert un cadeau de valeur %d (%s) à %s.",
+	"entry.give-gift-cancel":            "%s a offert un cadeau de valeur %d (%s) à %s et annulé le cadeau reçu de %s.",
+	"entry.invade-land":                 "%s a envahi la case de %d PV de %s.",
+	"entry.invade-land-paid":            "%s a dépensé %d cartes Confucius valant %d pièces pour envahir la case de %d PV de %s.",
+	"entry.invasion-succeeded":          "L'invasion de %s a réussi.",
+	"entry.invasion-failed":             "L'invasion de %s a échoué.",
+	"entry.invasion-points":             "%s a reçu %d points.",
+	"entry.invasion-reward-card":        "%s reçoit une carte Récompense de l'Empereur.",
+	"entry.ministry-resolved":           "Ministère %s résolu",
+	"entry.minister-awarded":            "%s obtient le poste de Ministre et %d points",
+	"entry.no-minister":                 "Personne n'obtient le poste de Ministre",
+	"entry.secretary-awarded":           "%s obtient le poste de Secrétaire et %d points",
+	"entry.no-secretary":                "Personne n'obtient le poste de Secrétaire",
+	"entry.no-action":                   "%s n'a effectué aucune action.",
+	"entry.nominate-student":            "%s a présenté un étudiant.",
+	"entry.nominate-student-paid":       "%s a dépensé %d %s valant %d pièces pour présenter un étudiant.",
+	"entry.pass":                        "%s a passé.",
+	"entry.auto-pass":                   "Le système a passé automatiquement pour %s.",
+	"entry.move-junks":                  "%s a utilisé un cadeau de valeur 2 (Tuile) pour adresser une pétition à l'Empereur et déplacer %d %s de %s à %s.",
+	"entry.replace-student":             "%s a utilisé un cadeau de valeur 3 (Vase) pour adresser une pétition à l'Empereur et remplacer l'étudiant de %s par le sien.",
+	"entry.swap-officials":              "%s a utilisé un cadeau de valeur 4 (Manteau) pour échanger le fonctionnaire de rang %[3]d du ministère %[2]s avec le fonctionnaire de rang %[5]d du ministère %[4]s.",
+	"entry.redeploy-army":               "%s a utilisé un cadeau de valeur 5 (Collier) pour redéployer une armée de la case de %d points de %s vers la case de %d points de %s.",
+	"entry.replace-influence":           "%s a utilisé un cadeau de valeur 6 (Jonque) pour remplacer le marqueur non protégé de %s sur le fonctionnaire de rang %[4]d du ministère %[3]s par un marqueur protégé de %[5]s.",
+	"entry.place-student-unable":        "%s n'a pas pu placer d'étudiant.",
+	"entry.place-student":               "%s a placé un étudiant au rang %d du ministère %s.",
+	"entry.place-student-replace":       "%s a placé un étudiant au rang %d du ministère %s en remplaçant le fonctionnaire de %s.",
+	"entry.recruit-army":                "%s a dépensé %d cartes Confucius valant %d licences pour recruter une armée.",
+	"entry.secure-official":             "%s a dépensé %d %s valant %d pièces pour protéger le fonctionnaire de rang %[6]d du ministère %[5]s.",
+	"entry.court-card":                  "%s a tiré la carte de la cour %s.",
+	"entry.court-card-not-taken":        "%s a tiré la carte de la cour %s, mais n'a pas pu effectuer l'action.",
+	"entry.solo-won":                    "%s a atteint l'objectif de %d points et vaincu la cour de l'Empereur.",
+	"entry.solo-lost":                   "%s n'a pas atteint l'objectif de %d points et a été vaincu par la cour de l'Empereur.",
+	"entry.start-voyage-paid":           "%s a dépensé %d %s Confucius valant %d %s pour envoyer %d %s en voyage.",
+	"entry.start-voyage":                "%s a envoyé %d %s en voyage.",
+	"entry.voyage-completed-reward":     "%s a terminé un voyage vers %s, marqué %d points et reçu une carte Récompense de l'Empereur.",
+	"entry.voyage-completed":            "%s a terminé un voyage vers %s, marqué %d points et n'a pas reçu de carte Récompense de l'Empereur.",
+	"entry.tax-income":                  "%s a reçu deux cartes Confucius de revenu fiscal.",
+	"entry.temp-transfer":               "%s a temporairement transféré son influence dans le ministère %s à %s.",
+	"entry.temp-transfer-gift":          "%s a temporairement transféré son influence dans le ministère %s à %s, et retiré le cadeau %s du jeu.",
+	"entry.auto-temp-transfer":          "Le système a temporairement transféré l'influence dans le ministère %s de %s à %s.",
+	"entry.auto-temp-transfer-gift":     "Le système a temporairement transféré l'influence dans le ministère %s de %s à %s, et retiré le cadeau %s du jeu.",
+	"entry.decision-default":            "%s n'a pas décidé à temps ; le choix a été fait par défaut.",
+	"entry.advance-stalled-turn":        "Un administrateur a fait avancer le tour bloqué de %s.",
+	"entry.advance-stalled-game":        "Un administrateur a fait avancer la partie bloquée.",
+	"entry.transfer-influence":          "%s a transféré son influence sur le fonctionnaire de rang %[3]d du ministère %[2]s à %[4]s.",
+	"entry.transfer-influence-gift":     "%s a transféré son influence sur le fonctionnaire de rang %[3]d du ministère %[2]s à %[4]s, et retiré du jeu le cadeau %[5]s de %[6]s.",
+	"entry.auto-tutor-student":          "%s a automatiquement dépensé %d %s pour instruire l'étudiant de %s.",
+	"entry.tutor-student":               "%s a dépensé %d %s pour instruire l'étudiant de %s.",
+	"entry.tutor-student-cancel":        "%s a dépensé %d %s pour instruire l'étudiant de %s et annulé le cadeau reçu de %s.",
+	"entry.tutor-student-no-cards":      "%s n'a pas de cartes pour instruire un étudiant.",
+	"entry.neutral-bribe":               "%s a corrompu le fonctionnaire de rang %d du ministère %s.",
+	"entry.neutral-tutor":               "%s a instruit son étudiant avec %d %s tirées de la pioche valant %d pièces.",
+	"entry.voyage-default-land":         "La terre lointaine a été choisie automatiquement.",
+	"entry.voyages-completed":           "%s a terminé %d %s et choisira les terres lointaines.",
+
+	// Validation Errors
+	"error.already-nominated-student":                         "Vous avez déjà un étudiant présenté.",
+	"error.already-taken-commercial-income-action-round":      "Vous avez déjà pris l'action de revenu commercial ce tour-ci.",
+	"error.been-muted-game":                                   "Vous avez été réduit au silence dans cette partie.",
+	"error.can-not-select-more-than-once":                     "Vous ne pouvez pas sélectionner %s plus d'une fois.",
+	"error.cannot-advance-phase":                              "La partie ne peut pas être avancée automatiquement pendant la phase %s.",
+	"error.cannot-appoint-yourself-chief-minister":            "Vous ne pouvez pas vous nommer premier ministre.",
+	"error.cannot-auto-pay":                                   "Vous ne pouvez pas payer %d %s avec les cartes de votre main sans jouer les cartes à garder.",
+	"error.cannot-choose-chief-minister-during-phase":         "Vous ne pouvez pas choisir de premier ministre pendant la phase %s.",
+	"error.cannot-discard-cards-during-phase":                 "Vous ne pouvez pas défausser de cartes pendant la phase %s.",
+	"error.cannot-force-examination-during-round":             "Vous ne pouvez pas forcer un examen pendant le tour %d.",
+	"error.cannot-nominate-student-during-round":              "Vous ne pouvez pas présenter d'étudiant pendant le tour %d.",
+	"error.cannot-pay-tutor-student-during-phase":             "Vous ne pouvez pas payer pour instruire un étudiant pendant la phase %s.",
+	"error.cannot-perform-action-during-phase":                "Vous ne pouvez pas effectuer l'action %q pendant la phase %s.",
+	"error.cannot-perform-player-action-after-passing":        "Vous ne pouvez pas effectuer d'action après avoir passé.",
+	"error.cannot-petition-emperor-basic-game":                "Vous ne pouvez pas adresser de pétition à l'empereur dans le jeu de base.",
+	"error.cannot-place-student-ministry":                     "Vous ne pouvez pas placer d'étudiant dans le ministère %s.",
+	"error.cannot-place-student-ministry-during-phase":        "Vous ne pouvez pas placer d'étudiant dans un ministère pendant la phase %s.",
+	"error.cannot-place-student-seniority-spot-ministry":      "Vous ne pouvez pas placer d'étudiant au rang %d du ministère %s.",
+	"error.cannot-temporarily-transfer-influence-ministry":    "Vous ne pouvez pas transférer temporairement votre influence dans le ministère %s à %s.",
+	"error.cannot-transfer-influence-during-phase":            "Vous ne pouvez pas transférer d'influence pendant la phase %s.",
+	"error.cant-bribe-official-already-marker":                "Vous ne pouvez pas corrompre un fonctionnaire qui a déjà un marqueur.",
+	"error.cant-give-yourself-gift":                           "Vous ne pouvez pas vous offrir un cadeau.",
+	"error.cant-redeploy-from-resolved-land":                  "Vous ne pouvez pas redéployer une armée depuis une terre étrangère résolue.",
+	"error.cant-redeploy-to-resolved-land":                    "Vous ne pouvez pas redéployer une armée vers une terre étrangère résolue.",
+	"error.cant-report-own-message":                           "Vous ne pouvez pas signaler votre propre message.",
+	"error.cant-transfer-influence-resolved-ministry":         "Vous ne pouvez pas transférer d'influence dans un ministère résolu.",
+	"error.channel-requires-at-least-two-players":             "Un canal nécessite au moins deux joueurs.",
+	"error.did-not-play-correct-emperors-reward":              "Vous n'avez pas joué la bonne carte Récompense de l'Empereur pour l'action choisie.",
+	"error.did-not-select-marker-another-player":              "Vous n'avez pas sélectionné le marqueur d'un autre joueur.",
+	"error.did-not-select-one-officials-swap":                 "Vous n'avez pas sélectionné l'un de vos fonctionnaires à échanger.",
+	"error.distant-land-already-visited":                      "Vous devez sélectionner une terre lointaine que vous n'avez pas encore visitée.",
+	"error.distant-land-chits-must-drawn":                     "Les jetons des terres lointaines doivent être tirés parmi %v.",
+	"error.dont-army-selected-box":                            "Vous n'avez pas d'armée dans la case sélectionnée.",
+	"error.dont-gift-value-buy":                               "Vous n'avez pas de cadeau de valeur %d à acheter.",
+	"error.dont-gift-value-give":                              "Vous n'avez pas de cadeau de valeur %d à offrir.",
+	"error.dont-influence-over-official-having-seniority":     "Vous n'avez pas d'influence sur le fonctionnaire de rang %d du ministère %s.",
+	"error.dont-selected-emperors-reward-card":                "Vous n'avez pas la carte Récompense de l'Empereur sélectionnée.",
+	"error.dont-value-2-tile-gift-petition":                   "Vous n'avez pas de cadeau de valeur 2 (Tuile) pour adresser une pétition à l'Empereur.",
+	"error.dont-value-3-vase-gift-petition":                   "Vous n'avez pas de cadeau de valeur 3 (Vase) pour adresser une pétition à l'Empereur.",
+	"error.dont-value-4-coat-gift-petition":                   "Vous n'avez pas de cadeau de valeur 4 (Manteau) pour adresser une pétition à l'Empereur.",
+	"error.dont-value-5-necklace-gift-petition":               "Vous n'avez pas de cadeau de valeur 5 (Collier) pour adresser une pétition à l'Empereur.",
+	"error.dont-value-6-junk-gift-petition":                   "Vous n'avez pas de cadeau de valeur 6 (Jonque) pour adresser une pétition à l'Empereur.",
+	"error.each-table-must-seat-at-least":                     "Chaque table doit accueillir au moins 2 joueurs.",
+	"error.game-not-found":                                    "Partie introuvable.",
+	"error.game-not-stalled":                                  "La partie n'est pas bloquée.",
+	"error.game-state-changed":                                "L'état de la partie a changé entre-temps.  Réessayez.",
+	"error.gift-obligation-prevents-bribing-another-official": "Votre obligation envers %s pour un cadeau vous empêche de corrompre un autre fonctionnaire du ministère %s.",
+	"error.improper-phase-finishing-turn":                     "Phase incorrecte pour terminer le tour.",
+	"error.insufficient-coins-bribe":                          "Les cartes sélectionnées valent %d pièces au total, mais il faut %d pièces pour corrompre le fonctionnaire sélectionné.",
+	"error.insufficient-coins-force-exam":                     "Les cartes sélectionnées valent %d pièces au total, mais il faut 2 pièces pour forcer un examen.",
+	"error.insufficient-coins-gift":                           "Les cartes sélectionnées valent %d pièces au total, mais le cadeau %s coûte %d pièces.",
+	"error.insufficient-coins-invade":                         "Les cartes sélectionnées valent %d pièces au total, mais il faut %d pièces pour envahir la terre sélectionnée.",
+	"error.insufficient-coins-junks":                          "Les cartes sélectionnées valent %d pièces au total, mais il faut %d pièces pour acheter les jonques sélectionnées.",
+	"error.insufficient-coins-nominate":                       "Les cartes sélectionnées valent %d pièces au total, mais il faut 2 pièces pour présenter un étudiant.",
+	"error.insufficient-coins-secure":                         "Les cartes sélectionnées valent %d pièces au total, mais il faut %d pièces pour protéger le fonctionnaire sélectionné.",
+	"error.insufficient-licenses-recruit":                     "Les cartes sélectionnées valent %d licences au total, mais il faut %d licences pour recruter une armée.",
+	"error.insufficient-licenses-voyage":                      "Les cartes sélectionnées valent %d licences au total, mais il faut %d licences pour partir en voyage avec %d jonques.",
+	"error.invalid-action":                                    "%q est une action invalide.",
+	"error.invalid-box-index":                                 "Valeur invalide reçue pour la case %q : boxIndex : %d, nombre de cases : %d.",
+	"error.invalid-box-value":                                 "Valeur invalide reçue pour la case %q.",
+	"error.invalid-format-ministry-seniority-param":           "Format invalide pour le paramètre ministère/rang.",
+	"error.invalid-ministry-provided":                         "Ministère invalide.",
+	"error.invalid-official-selected":                         "Fonctionnaire sélectionné invalide.",
+	"error.invalid-official-seniority-provided":               "Rang de fonctionnaire invalide.",
+	"error.invalid-tile-value":                                "Valeur invalide reçue pour la tuile %q.",
+	"error.invalid-value-coin-1-cards-received":               "Valeur invalide reçue pour les cartes à 1 pièce.",
+	"error.invalid-value-coin-2-cards-received":               "Valeur invalide reçue pour les cartes à 2 pièces.",
+	"error.invalid-value-coin-3-cards-received":               "Valeur invalide reçue pour les cartes à 3 pièces.",
+	"error.invalid-value-junks-received":                      "Valeur invalide reçue pour les jonques.",
+	"error.may-only-change-own-messages":                      "Vous ne pouvez modifier que vos propres messages.",
+	"error.may-only-pay-up-4-coins":                           "Vous ne pouvez payer que 4 pièces au maximum. Vous avez payé %d pièces.",
+	"error.may-post-no-more-than-messages":                    "Vous ne pouvez pas publier plus de %d messages par minute.",
+	"error.message-already-been-removed":                      "Le message a déjà été retiré.",
+	"error.message-been-deleted":                              "Le message a été supprimé.",
+	"error.message-been-hidden-by-moderator":                  "Le message a été masqué par un modérateur.",
+	"error.messages-limited-characters":                       "Les messages sont limités à %d caractères.",
+	"error.messages-may-only-changed-within-being":            "Les messages ne peuvent être modifiés que dans les %v suivant leur publication.",
+	"error.ministry-chits-must-drawn":                         "Les jetons des ministères doivent être tirés parmi %v.",
+	"error.must-at-least-action-cubes-perform":                "Vous devez avoir au moins %d cubes d'action pour effectuer cette action.",
+	"error.must-be-logged-in":                                 "Vous devez être connecté.",
+	"error.must-be-logged-in-create-tournament":               "Vous devez être connecté pour créer un tournoi.",
+	"error.must-discard-down-4-cards-discarded":               "Vous devez défausser jusqu'à 4 cartes.  Il vous reste %d cartes.",
+	"error.must-enter-message":                                "Vous devez saisir un message.",
+	"error.must-give-gift-greater-than-equal":                 "Vous devez offrir un cadeau supérieur ou égal à celui que le joueur vous a offert.",
+	"error.must-give-gift-greater-than-present":               "Vous devez offrir un cadeau supérieur à votre cadeau actuel au joueur.",
+	"error.must-marker-official-before-securing-it":           "Vous devez avoir un marqueur sur le fonctionnaire avant de le protéger.",
+	"error.must-play-at-least-one-confucius":                  "Vous devez jouer au moins une carte Confucius.",
+	"error.must-provide-2-chits-each-ministries":              "Vous devez fournir 2 jetons pour chacun des %d ministères.",
+	"error.must-provide-chit-each-distant-lands":              "Vous devez fournir un jeton pour chacune des %d terres lointaines.",
+	"error.must-select-box-redeploy-from":                     "Vous devez sélectionner la case depuis laquelle redéployer une armée.",
+	"error.must-select-box-redeploy-to":                       "Vous devez sélectionner la case vers laquelle redéployer une armée.",
+	"error.must-select-distant-land":                          "Vous devez sélectionner une terre lointaine.",
+	"error.must-select-emperors-reward-card":                  "Vous devez sélectionner une carte Récompense de l'Empereur.",
+	"error.must-select-exactly-3-foreign-lands":               "Vous devez sélectionner exactement 3 terres étrangères.",
+	"error.must-select-gift-card":                             "Vous devez sélectionner une carte cadeau.",
+	"error.must-select-land-redeploy-army":                    "Vous devez sélectionner la terre depuis laquelle redéployer une armée.",
+	"error.must-select-official":                              "Vous devez sélectionner un fonctionnaire.",
+	"error.must-select-official-doesnt-marker":                "Vous devez sélectionner un fonctionnaire qui n'a pas votre marqueur.",
+	"error.must-select-official-doesnt-secured-marker":        "Vous devez sélectionner un fonctionnaire qui n'a pas de marqueur protégé.",
+	"error.must-select-official-marker":                       "Vous devez sélectionner un fonctionnaire ayant un marqueur.",
+	"error.must-select-official-without-secured-marker":       "Vous devez sélectionner un fonctionnaire sans marqueur protégé.",
+	"error.must-select-player":                                "Vous devez sélectionner un joueur.",
+	"error.must-select-player-move-junks-from":                "Vous devez sélectionner le joueur dont déplacer les jonques.",
+	"error.must-select-player-move-junks-to":                  "Vous devez sélectionner le joueur vers lequel déplacer les jonques.",
+	"error.must-select-valid-ministry-selected-card":          "Vous devez sélectionner un ministère valide pour la carte sélectionnée.",
+	"error.must-use-all-action-cubes-before":                  "Vous devez utiliser tous vos cubes d'action avant de passer.",
+	"error.no-armies-recruit":                                 "Vous n'avez pas d'armée à recruter.",
+	"error.no-junks-move":                                     "%s n'a pas de jonques à déplacer.",
+	"error.no-ministry-resolution-progress":                   "Aucune résolution de ministère en cours.",
+	"error.no-pending-decision":                               "Aucune décision n'est attendue.",
+	"error.no-pending-voyage":                                 "Vous n'avez aucun voyage terminé en attente d'une terre lointaine.",
+	"error.no-recruited-armies-avenge-emperor":                "Vous n'avez pas d'armée recrutée pour venger l'Empereur.",
+	"error.no-recruited-armies-invasion":                      "Vous n'avez pas d'armée recrutée pour une invasion.",
+	"error.no-transfer-awaited":                               "Vous n'avez pas à choisir à qui transférer votre influence.",
+	"error.not-valid-action":                                  "%v n'est pas une action valide.",
+	"error.only-admins-may-create-tournaments":                "Seuls les administrateurs peuvent créer des tournois.",
+	"error.only-current-chief-minister-may-select":            "Seul le premier ministre actuel peut choisir son successeur.",
+	"error.only-current-player-may-choose-chief":              "Seul le joueur actif peut choisir un premier ministre.",
+	"error.only-current-player-may-discard-cards":             "Seul un joueur actif peut défausser des cartes.",
+	"error.only-current-player-may-finish-turn":               "Seul le joueur actif peut terminer un tour.",
+	"error.only-current-player-may-pay-tutor":                 "Seul le joueur actif peut payer pour instruire un étudiant.",
+	"error.only-current-player-may-perform-action":            "Seul le joueur actif peut effectuer cette action.",
+	"error.only-current-player-may-perform-player":            "Seul le joueur actif peut effectuer l'action %q.",
+	"error.only-current-player-may-place-student":             "Seul le joueur actif peut placer un étudiant dans un ministère.",
+	"error.only-players-may-open-channel":                     "Seuls les joueurs de la partie peuvent ouvrir un canal.",
+	"error.only-players-may-report-messages":                  "Seuls les joueurs de la partie peuvent signaler des messages.",
+	"error.overpayment":                                       "Les cartes sélectionnées valent %d %s au total, mais le coût est de %d ; vous le paieriez encore sans une carte valant %d %s, gardez donc cette carte.",
+	"error.player-form-value-not-found":                       "Valeur de formulaire %q introuvable.",
+	"error.player-may-only-enter-tournament-once":             "Un joueur ne peut s'inscrire qu'une fois à un tournoi.",
+	"error.provided-incorrect-player":                         "Vous avez fourni un joueur incorrect.",
+	"error.received-invalid-foreign-land":                     "Terre étrangère invalide reçue.",
+	"error.received-invalid-message":                          "Message invalide reçu.",
+	"error.received-invalid-player":                           "Joueur invalide reçu.",
+	"error.received-invalid-report":                           "Signalement invalide reçu.",
+	"error.received-invalid-tie-breaker":                      "Critère de départage invalide reçu.",
+	"error.received-invalid-tournament-format":                "Format de tournoi invalide reçu.",
+	"error.received-invalid-user":                             "Utilisateur invalide reçu.",
+	"error.recipient-not-found":                               "Destinataire introuvable.",
+	"error.save-failed":                                       "La partie n'a pas pu être enregistrée : %s",
+	"error.selected-cards-one-coin-but-only":                  "Vous avez sélectionné %d cartes à une pièce, mais n'en avez que %d.",
+	"error.selected-cards-three-coins-but-only":               "Vous avez sélectionné %d cartes à trois pièces, mais n'en avez que %d.",
+	"error.selected-cards-two-coins-but-only":                 "Vous avez sélectionné %d cartes à deux pièces, mais n'en avez que %d.",
+	"error.selected-gift-card-not-available":                  "La carte cadeau sélectionnée n'est pas disponible.",
+	"error.selected-junks-voyage-buy-only-junks":              "Vous avez sélectionné %d jonques pour le voyage, mais n'avez que %d jonques disponibles.",
+	"error.selected-land-box-already-army":                    "La case sélectionnée a déjà une armée.",
+	"error.selected-more-junks-than-there-available":          "Vous avez sélectionné plus de jonques qu'il n'y en a en réserve.",
+	"error.selected-official-another-player-higher-seniority": "Vous avez sélectionné un fonctionnaire d'un autre joueur de rang supérieur.",
+	"error.selected-official-resolved-ministry":               "Vous avez sélectionné un fonctionnaire d'un ministère résolu.",
+	"error.selected-official-without-marker":                  "Vous avez sélectionné un fonctionnaire sans marqueur.",
+	"error.selected-player-does-not-student":                  "Le joueur sélectionné n'a pas d'étudiant.",
+	"error.selected-player-not-found":                         "Joueur sélectionné introuvable.",
+	"error.selected-secured-official":                         "Vous avez sélectionné un fonctionnaire protégé.",
+	"error.selected-space-without-official":                   "Vous avez sélectionné un emplacement sans fonctionnaire.",
+	"error.tables-must-seat-2-5-players":                      "Les tables doivent accueillir de 2 à 5 joueurs.",
+	"error.there-already-two-students":                        "Il y a déjà deux étudiants.",
+	"error.there-no-distant-lands-can-voyage":                 "Il n'y a aucune terre lointaine vers laquelle vous pouvez voyager.",
+	"error.there-no-student-replace":                          "Il n'y a pas d'étudiant à remplacer.",
+	"error.tournament-must-1-rounds":                          "Un tournoi doit compter de 1 à %d rondes.",
+	"error.tournament-requires-at-least-2-players":            "Un tournoi nécessite au moins 2 joueurs.",
+	"error.variant-requires-players":                          "La variante %s nécessite %d joueurs.",
+	"error.yet-perform-action":                                "%s n'a pas encore effectué d'action.",
+}
@@ -3,7 +3,6 @@ package confucius
 import (
 	"encoding/gob"
 	"html/template"
-	"math/rand"
 	"strconv"
 
 	"github.com/SlothNinja/game"
@@ -18,20 +17,24 @@ func init() {
 	gob.RegisterName("*game.chooseChiefMinisterEntry", new(chooseChiefMinisterEntry))
 }
 
-func (g *Game) chooseChiefMinisterPhase() {
+func (g *Game) chooseChiefMinisterPhase() error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = ChooseChiefMinister
+	if err := g.setPhase(ChooseChiefMinister); err != nil {
+		return err
+	}
 	if g.Round == 1 {
 		g.RandomTurnOrder()
 		g.SetChiefMinister(g.CurrentPlayer())
 		g.ChiefMinister().PlaceCubesIn(ImperialFavourSpace, 1)
 		g.SetCurrentPlayerers(g.nextPlayer())
-		g.actionsPhase()
-	} else {
-		g.SetCurrentPlayerers(g.ChiefMinister())
+		return g.actionsPhase()
+	} else if g.HasVariant(SoloVariant) {
+		return g.soloChooseChiefMinister()
 	}
+	g.SetCurrentPlayerers(g.ChiefMinister())
+	return nil
 }
 
 func (g *Game) chooseChiefMinister(c *gin.Context, cu *user.User) (string, game.ActionType, error) {
@@ -75,7 +78,7 @@ func (p *Player) newChooseChiefMinisterEntry(op *Player) *chooseChiefMinisterEnt
 }
 
 func (e *chooseChiefMinisterEntry) HTML() template.HTML {
-	return restful.HTML("%s chose %s to be chief minister.", e.Player().Name(), e.OtherPlayer().Name())
+	return e.locale().HTML("entry.choose-chief-minister", e.Player().Name(), e.OtherPlayer().Name())
 }
 
 func (g *Game) validateChooseChiefMinister(c *gin.Context, cu *user.User) (*Player, error) {
@@ -91,25 +94,35 @@ func (g *Game) validateChooseChiefMinister(c *gin.Context, cu *user.User) (*Play
 	cp := g.CurrentPlayer()
 	switch {
 	case recipient == nil:
-		return nil, sn.NewVError("Recipient not found.")
+		return nil, g.vError("error.recipient-not-found")
 	case !g.IsCurrentPlayer(cu):
-		return nil, sn.NewVError("Only the current player may choose a chief minister.")
+		return nil, g.vError("error.only-current-player-may-choose-chief")
 	case g.Phase != ChooseChiefMinister:
-		return nil, sn.NewVError("You cannot choose a chief minister during the %s phase.", g.Phase)
+		return nil, g.vError("error.cannot-choose-chief-minister-during-phase", g.PhaseName())
 	case cp.NotEqual(g.ChiefMinister()):
-		return nil, sn.NewVError("Only the current chief minister may select the succeeding chief minister.")
+		return nil, g.vError("error.only-current-chief-minister-may-select")
 	case cp.Equal(recipient):
-		return nil, sn.NewVError("You cannot appoint yourself chief minister.")
+		return nil, g.vError("error.cannot-appoint-yourself-chief-minister")
 	}
 	return recipient, nil
 }
 
 func (g *Game) EnableChooseChiefMinister(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.Phase == ChooseChiefMinister
+	return g.ChooseChiefMinisterReasons(cu).None()
+}
+
+// ChooseChiefMinisterReasons returns why the user cu may not choose the chief minister.
+func (g *Game) ChooseChiefMinisterReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.phase(ChooseChiefMinister)
+	return r.reasons
 }
 
 func (g *Game) RandomTurnOrder() {
-	rand.Shuffle(len(g.Playerers), func(i, j int) {
+	sn.MyRand.Shuffle(len(g.Playerers), func(i, j int) {
 		g.Playerers[i], g.Playerers[j] = g.Playerers[j], g.Playerers[i]
 	})
 	g.SetCurrentPlayerers(g.Playerers[0])
@@ -0,0 +1,167 @@
+package confucius
+
+import (
+	"encoding/gob"
+	"html/template"
+	"strconv"
+
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/restful"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gob.RegisterName("*game.completeVoyageEntry", new(completeVoyageEntry))
+}
+
+func (g *Game) chooseDistantLand(c *gin.Context, cu *user.User) (string, game.ActionType, error) {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	land, err := g.validateChooseDistantLand(c, cu)
+	if err != nil {
+		return "", game.None, err
+	}
+
+	cp := g.CurrentPlayer()
+	left := g.voyagesLeft()
+	e := cp.resolveVoyage(land, false)
+	cp.resolveForcedVoyages()
+
+	// Set flash message
+	restful.AddNoticef(c, string(e.HTML()))
+	return "", g.commitIfDrawn(cp, left), nil
+}
+
+func (g *Game) validateChooseDistantLand(c *gin.Context, cu *user.User) (*DistantLand, error) {
+	cp := g.CurrentPlayer()
+	if !g.IsCurrentPlayer(cu) {
+		return nil, g.vError("error.only-current-player-may-perform-action")
+	}
+
+	if cp.PendingVoyages < 1 {
+		return nil, g.vError("error.no-pending-voyage")
+	}
+
+	id, err := strconv.Atoi(c.PostForm("distant-land"))
+	if err != nil {
+		return nil, g.vError("error.must-select-distant-land")
+	}
+
+	for _, land := range g.eligibleDistantLandsFor(cp) {
+		if int(land.ID) == id {
+			return land, nil
+		}
+	}
+	return nil, g.vError("error.distant-land-already-visited")
+}
+
+func (g *Game) EnableChooseDistantLand(cu *user.User) bool {
+	return g.ChooseDistantLandReasons(cu).None()
+}
+
+// ChooseDistantLandReasons returns why the user cu may not choose the distant land of a voyage.
+func (g *Game) ChooseDistantLandReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.require(cp.PendingVoyages > 0, "reason.no-pending-voyage")
+	return r.reasons
+}
+
+// eligibleDistantLandsFor returns the distant lands the player has yet to visit.
+func (g *Game) eligibleDistantLandsFor(p *Player) DistantLands {
+	var lands DistantLands
+	for _, land := range g.DistantLands {
+		if !land.Players().Include(p) {
+			lands = append(lands, land)
+		}
+	}
+	return lands
+}
+
+// defaultDistantLandFor returns the distant land in which a voyage of the player completes where the
+// player makes no choice: the land with the most valuable chit or, where all chits are taken, the
+// first land the player has yet to visit.  It returns nil if the player has visited every land.
+func (g *Game) defaultDistantLandFor(p *Player) *DistantLand {
+	var land *DistantLand
+	for _, l := range g.eligibleDistantLandsFor(p) {
+		if land == nil || l.Chit.Value() > land.Chit.Value() {
+			land = l
+		}
+	}
+	return land
+}
+
+// resolveForcedVoyages resolves the pending voyages of the player for which there is no choice of
+// distant land.
+func (p *Player) resolveForcedVoyages() {
+	for p.PendingVoyages > 0 {
+		lands := p.Game().eligibleDistantLandsFor(p)
+		switch len(lands) {
+		case 0:
+			p.resolveVoyage(nil, true)
+		case 1:
+			p.resolveVoyage(lands[0], true)
+		default:
+			return
+		}
+	}
+}
+
+// resolvePendingVoyages resolves the pending voyages of the player in the distant lands chosen by
+// default, as where the turn of the player ends before the player chooses.
+func (p *Player) resolvePendingVoyages() {
+	for p.PendingVoyages > 0 {
+		p.resolveVoyage(p.Game().defaultDistantLandFor(p), true)
+	}
+}
+
+// resolveVoyage completes a pending voyage of the player in the land.  The voyage is forfeited where
+// the land is nil, as the player has visited every distant land.
+func (p *Player) resolveVoyage(land *DistantLand, auto bool) *completeVoyageEntry {
+	p.PendingVoyages--
+	if land == nil {
+		return nil
+	}
+	scored, drawn := p.completeVoyage(land)
+	p.Game().emit(&VoyageCompleted{PlayerID: p.ID(), DistantLand: land.ID, Points: scored})
+	return p.newCompleteVoyageEntry(land, scored, drawn, auto)
+}
+
+type completeVoyageEntry struct {
+	*Entry
+	DistantLand DistantLandID
+	Points      int
+	EmperorCard bool
+	Auto        bool
+}
+
+func (p *Player) newCompleteVoyageEntry(land *DistantLand, points int, card, auto bool) *completeVoyageEntry {
+	g := p.Game()
+	e := new(completeVoyageEntry)
+	e.Entry = p.newEntry()
+	e.DistantLand = land.ID
+	e.Points = points
+	e.EmperorCard = card
+	e.Auto = auto
+	p.Log = append(p.Log, e)
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *completeVoyageEntry) HTML() template.HTML {
+	l := e.locale()
+	id := "entry.voyage-completed"
+	if e.EmperorCard {
+		id = "entry.voyage-completed-reward"
+	}
+	s := l.Sprintf(id, e.Player().Name(), l.Name(e.DistantLand.String()), e.Points)
+	if e.Auto {
+		s += " " + l.Sprintf("entry.voyage-default-land")
+	}
+	return template.HTML(s)
+}
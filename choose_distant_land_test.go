@@ -0,0 +1,193 @@
+package confucius
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/sn"
+)
+
+// setDistantLands sets the chits of the distant lands, by land, and marks the lands visited by the
+// player.
+func setDistantLands(g *Game, p *Player, chits []DistantLandChit, visited ...DistantLandID) {
+	for i, land := range g.DistantLands {
+		land.Chit = chits[i]
+		land.SetPlayers(nil)
+	}
+	for _, id := range visited {
+		g.DistantLands[id].SetPlayers(Players{p})
+	}
+}
+
+// visitedBy returns the distant lands visited by the player.
+func visitedBy(g *Game, p *Player) []DistantLandID {
+	var ids []DistantLandID
+	for _, land := range g.DistantLands {
+		if land.Players().Include(p) {
+			ids = append(ids, land.ID)
+		}
+	}
+	return ids
+}
+
+func TestDefaultDistantLand(t *testing.T) {
+	tests := []struct {
+		name    string
+		chits   []DistantLandChit
+		visited []DistantLandID
+		want    DistantLandID
+		wantNil bool
+	}{
+		{"most valuable chit", []DistantLandChit{1, 2, 3, 2, 1}, nil, Arabia, false},
+		{"tie between equal chits", []DistantLandChit{1, 3, 2, 3, 1}, nil, India, false},
+		{"tie with the first land visited", []DistantLandChit{1, 3, 2, 3, 1}, []DistantLandID{India}, Africa, false},
+		{"chits taken", []DistantLandChit{NoChit, NoChit, 2, NoChit, NoChit}, []DistantLandID{Arabia}, SpiceIslands, false},
+		{"chit over no chit", []DistantLandChit{NoChit, NoChit, NoChit, NoChit, 1}, nil, Americas, false},
+		{"all lands visited", []DistantLandChit{1, 2, 3, 2, 1}, distanLandIDS, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 3)
+			cp := g.CurrentPlayer()
+			setDistantLands(g, cp, tt.chits, tt.visited...)
+
+			land := g.defaultDistantLandFor(cp)
+			switch {
+			case tt.wantNil && land != nil:
+				t.Errorf("land: got %v, want none", land.ID)
+			case !tt.wantNil && land == nil:
+				t.Errorf("land: got none, want %v", tt.want)
+			case !tt.wantNil && land.ID != tt.want:
+				t.Errorf("land: got %v, want %v", land.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveForcedVoyages(t *testing.T) {
+	chits := []DistantLandChit{1, 2, 3, 2, 1}
+	tests := []struct {
+		name        string
+		visited     []DistantLandID
+		pending     int
+		wantPending int
+		wantVisited int
+		wantScore   int
+	}{
+		{"choice of lands", []DistantLandID{India, Arabia, Africa}, 1, 1, 3, 0},
+		{"single eligible land", []DistantLandID{SpiceIslands, India, Arabia, Africa}, 1, 0, 5, 1},
+		{"all lands visited", distanLandIDS, 1, 0, 5, 0},
+		{"single land, then forfeited", []DistantLandID{India, Arabia, Africa, Americas}, 2, 0, 5, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 3)
+			cp := g.CurrentPlayer()
+			setDistantLands(g, cp, chits, tt.visited...)
+			cp.PendingVoyages = tt.pending
+			score, logged := cp.Score, len(g.Log)
+
+			cp.resolveForcedVoyages()
+			if cp.PendingVoyages != tt.wantPending {
+				t.Errorf("pending voyages: got %d, want %d", cp.PendingVoyages, tt.wantPending)
+			}
+			if got := len(visitedBy(g, cp)); got != tt.wantVisited {
+				t.Errorf("lands visited: got %d, want %d", got, tt.wantVisited)
+			}
+			if got := cp.Score - score; got != tt.wantScore {
+				t.Errorf("score: got %d, want %d", got, tt.wantScore)
+			}
+			if got, want := len(g.Log)-logged, len(visitedBy(g, cp))-len(tt.visited); got != want {
+				t.Errorf("voyages logged: got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateChooseDistantLand(t *testing.T) {
+	tests := []struct {
+		name    string
+		other   bool
+		pending int
+		land    string
+		want    DistantLandID
+		wantErr string
+	}{
+		{"eligible land", false, 1, "2", Arabia, ""},
+		{"land visited", false, 1, "1", 0, "error.distant-land-already-visited"},
+		{"no land", false, 1, "", 0, "error.must-select-distant-land"},
+		{"unknown land", false, 1, "9", 0, "error.distant-land-already-visited"},
+		{"no pending voyage", false, 0, "2", 0, "error.no-pending-voyage"},
+		{"not current player", true, 1, "2", 0, "error.only-current-player-may-perform-action"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g := newTestGame(t, 3)
+			cp := g.CurrentPlayer()
+			setDistantLands(g, cp, []DistantLandChit{1, 2, 3, 2, 1}, India)
+			cp.PendingVoyages = tt.pending
+			cu := g.Users[cp.ID()]
+			if tt.other {
+				cu = g.Users[g.nextPlayer().ID()]
+			}
+			postForm(c, url.Values{"distant-land": {tt.land}})
+
+			land, err := g.validateChooseDistantLand(c, cu)
+			if tt.wantErr != "" {
+				if want := g.Locale().Sprintf(tt.wantErr); err == nil || strings.TrimSpace(err.Error()) != want {
+					t.Errorf("error: got %v, want %q", err, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if land.ID != tt.want {
+				t.Errorf("land: got %v, want %v", land.ID, tt.want)
+			}
+		})
+	}
+}
+
+// TestFinishTurnResolvesVoyages checks that the voyages a player leaves pending at the end of the
+// turn complete in the distant lands chosen by default.
+func TestFinishTurnResolvesVoyages(t *testing.T) {
+	tests := []struct {
+		name        string
+		phase       game.Phase
+		pending     int
+		wantVisited []DistantLandID
+	}{
+		{"actions", Actions, 1, []DistantLandID{India, Arabia}},
+		{"actions, voyage forfeited", Actions, 5, distanLandIDS},
+		{"imperial favour", ImperialFavour, 2, []DistantLandID{India, Arabia, Africa}},
+	}
+
+	client := &Client{Client: &sn.Client{Log: new(log.Logger)}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g := newTestGame(t, 3)
+			cp := g.CurrentPlayer()
+			g.Phase = tt.phase
+			setDistantLands(g, cp, []DistantLandChit{1, 2, 3, 3, 1}, India)
+			cp.PendingVoyages, cp.PerformedAction = tt.pending, true
+
+			if _, _, err := client.finishTurn(c, g, g.Users[cp.ID()]); err != nil {
+				t.Fatal(err)
+			}
+			if cp.PendingVoyages != 0 {
+				t.Errorf("pending voyages: got %d, want 0", cp.PendingVoyages)
+			}
+			if got := visitedBy(g, cp); !reflect.DeepEqual(got, tt.wantVisited) {
+				t.Errorf("lands visited: got %v, want %v", got, tt.wantVisited)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The contract between the server and the client is kept in testdata: actions.json is served by
+// the server for a fixed game, as checked by the tests of the server, and forms.json holds the forms
+// the client posts for each of those actions, which the tests of the server take on that game.
+var update = flag.Bool("update", false, "update the forms of testdata/forms.json")
+
+// contractForm is a form posted by the client, with the arguments of the do command sending it.
+type contractForm struct {
+	Args []string
+	Form url.Values
+}
+
+// contractCards gives the cards played with the actions that do not pay a cost.
+var contractCards = map[string]string{
+	"commercial":          "1",
+	"force-exam":          "1",
+	"take-bribery-reward": "1",
+}
+
+// contractArgs returns the arguments of the do command taking the action, choosing the first
+// option of each field.
+func contractArgs(a *action) []string {
+	args := []string{a.Action}
+	for _, f := range a.Fields {
+		switch {
+		case f.Kind == "fixed":
+			args = append(args, f.Name+"="+f.Value)
+		case f.Kind == "cards" && f.AutoPay:
+			args = append(args, "cards=auto")
+		case f.Kind == "cards":
+			args = append(args, "cards="+contractCards[a.Action])
+		case len(f.Options) > 0:
+			args = append(args, f.Name+"="+f.Options[0])
+		default:
+			args = append(args, f.Name+"=1")
+		}
+	}
+	return args
+}
+
+func TestContract(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "actions.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data struct {
+		Actions     []*action
+		Unavailable []*unavailable
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Actions) == 0 {
+		t.Fatal("no actions")
+	}
+
+	var got []*contractForm
+	for _, a := range data.Actions {
+		args := contractArgs(a)
+		g := &memGame{ID: testGameID, UserID: testUserID, Actions: data.Actions, Unavailable: data.Unavailable}
+		s := newMemStore(g)
+		cl, _ := newTestClient(t, s, "session=1", "y\n")
+
+		if err := cl.run("do", append([]string{fmt.Sprint(testGameID)}, args...)); err != nil {
+			t.Errorf("do %s: %v", strings.Join(args, " "), err)
+			continue
+		}
+		if len(g.Taken) != 1 {
+			t.Errorf("do %s: actions taken: got %d, want 1", strings.Join(args, " "), len(g.Taken))
+			continue
+		}
+		got = append(got, &contractForm{Args: args, Form: g.Taken[0]})
+	}
+
+	path := filepath.Join("testdata", "forms.json")
+	if *update {
+		b, err := json.MarshalIndent(got, "", "\t")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, append(b, '\n'), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err = ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []*contractForm
+	if err := json.Unmarshal(b, &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("forms differ from %s; if the change is intended, run go test -update and the "+
+			"tests of the server", path)
+	}
+}
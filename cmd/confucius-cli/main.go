@@ -0,0 +1,557 @@
+// Command confucius-cli plays Confucius games from a terminal.
+//
+// The server signs users in with Google OAuth in a browser, which a terminal cannot do, so the
+// client has no login of its own.  Instead it borrows the session cookie of a browser already
+// signed in to the server, copied from the developer tools of the browser:
+//
+//	confucius-cli login 'session=MTYx...'
+//	confucius-cli games
+//	confucius-cli show 1234
+//	confucius-cli actions 1234
+//	confucius-cli do 1234 bribe-official cards=1,3 bribe-official=Hubu-3
+//	confucius-cli finish 1234
+//
+// The Confucius cards played with an action are given by their coins (e.g., cards=1,3), and are
+// submitted as the counts of cards of each coin value that the server expects.  Where the action
+// pays a cost, cards=auto lets the server choose the cards overpaying the least, keeping any cards
+// given by keep (e.g., keep=3).
+//
+// For local development, point -server at a server backed by the datastore emulator started with
+// --no-store-on-disk, which keeps games in memory.  The tests of the client run it against a local
+// server keeping games in an in-memory store, fed with the actions JSON of the real server kept in
+// testdata, whose tests in turn take the forms the client posts.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// updateActions lists the player actions accepted by Game.Update.
+var updateActions = []string{
+	"bribe-official", "secure-official", "buy-gift", "give-gift", "nominate-student", "force-exam",
+	"transfer-influence", "temp-transfer-influence", "move-junks", "replace-student", "swap-officials",
+	"redeploy-army", "replace-influence", "place-student", "buy-junks", "start-voyage",
+	"choose-distant-land", "commercial",
+	"tax-income", "recruit-army", "invade-land", "no-action", "pass", "take-cash", "take-gift",
+	"take-extra-action", "take-bribery-reward", "avenge-emperor", "take-army", "discard",
+	"choose-chief-minister", "tutor-student", "reset",
+}
+
+const usage = `usage: confucius-cli [flags] <command> [arguments]
+
+commands:
+  login <cookie>                       save the session cookie (name=value) of a signed-in browser
+  logout                               forget the saved session cookie
+  games                                list the games awaiting your turn
+  show <game>                          show the board as text
+  actions <game>                       list the actions you may take
+  do <game> <action> [field=value...]  take an action
+  finish <game>                        finish your turn
+  undo <game>                          undo the actions of your unfinished turn
+  reset <game>                         reset your turn
+
+flags:
+`
+
+type client struct {
+	server  string
+	prefix  string
+	cookie  string
+	session string
+	yes     bool
+	http    *http.Client
+	in      *bufio.Reader
+	out     io.Writer
+}
+
+type field struct {
+	Name    string
+	Kind    string
+	Value   string
+	Options []string
+	AutoPay bool
+}
+
+type action struct {
+	Action  string
+	Fields  []*field
+	Reveals bool
+	Warning string
+	Prompt  string
+}
+
+type unavailable struct {
+	Action  string
+	Reasons []string
+}
+
+type turn struct {
+	ID        int64
+	Title     string
+	Round     int
+	Phase     string
+	UpdatedAt time.Time
+}
+
+type result struct {
+	Notices []string
+	Errors  []string
+}
+
+func main() {
+	server := flag.String("server", envOr("CONFUCIUS_SERVER", "http://localhost:8080"), "server URL")
+	prefix := flag.String("prefix", "confucius", "path prefix of the game routes")
+	yes := flag.Bool("yes", false, "take actions that reveal hidden information without asking")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cl := newClient(*server, *prefix, sessionPath(), os.Stdin, os.Stdout)
+	cl.yes = *yes
+
+	if err := cl.run(args[0], args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "confucius-cli:", err)
+		os.Exit(1)
+	}
+}
+
+func newClient(server, prefix, session string, in io.Reader, out io.Writer) *client {
+	return &client{
+		server:  strings.TrimSuffix(server, "/"),
+		prefix:  "/" + strings.Trim(prefix, "/"),
+		session: session,
+		http: &http.Client{
+			Timeout: 30 * time.Second,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		in:  bufio.NewReader(in),
+		out: out,
+	}
+}
+
+func (cl *client) run(cmd string, args []string) error {
+	switch cmd {
+	case "login":
+		if len(args) != 1 || !strings.Contains(args[0], "=") {
+			return errors.New("login requires a session cookie of the form name=value")
+		}
+		return cl.login(args[0])
+	case "logout":
+		return os.Remove(cl.session)
+	}
+
+	cookie, err := ioutil.ReadFile(cl.session)
+	if err != nil {
+		return errors.New("not logged in; run confucius-cli login <cookie>")
+	}
+	cl.cookie = strings.TrimSpace(string(cookie))
+
+	if cmd == "games" {
+		return cl.games()
+	}
+
+	if len(args) < 1 {
+		return fmt.Errorf("%s requires a game id", cmd)
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid game id %q", args[0])
+	}
+
+	switch cmd {
+	case "show":
+		return cl.show(id)
+	case "actions":
+		return cl.actions(id)
+	case "do":
+		if len(args) < 2 {
+			return errors.New("do requires a game id and an action")
+		}
+		return cl.do(id, args[1], args[2:])
+	case "finish":
+		return cl.post(fmt.Sprintf("/game/finish/%d", id), nil, "Turn finished.")
+	case "undo":
+		return cl.post(fmt.Sprintf("/game/undo/%d", id), nil, "Turn undone.")
+	case "reset":
+		return cl.do(id, "reset", nil)
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// login saves the session cookie of a signed-in browser, once the server accepts it, as the session
+// of later commands.  It does not sign in by itself; the cookie expires with the browser session.
+func (cl *client) login(cookie string) error {
+	cl.cookie = cookie
+	var data struct{ Games []*turn }
+	if err := cl.getJSON("/turns/json", &data); err != nil {
+		return fmt.Errorf("unable to log in: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cl.session), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(cl.session, []byte(cookie), 0600); err != nil {
+		return err
+	}
+	fmt.Fprintf(cl.out, "Logged in.  %d %s awaiting your turn.\n", len(data.Games), plural(len(data.Games), "game", "games"))
+	return nil
+}
+
+func (cl *client) games() error {
+	var data struct{ Games []*turn }
+	if err := cl.getJSON("/turns/json", &data); err != nil {
+		return err
+	}
+
+	if len(data.Games) == 0 {
+		fmt.Fprintln(cl.out, "No games await your turn.")
+		return nil
+	}
+	for _, t := range data.Games {
+		fmt.Fprintf(cl.out, "%d\t%s\tround %d, %s\tupdated %s\n", t.ID, t.Title, t.Round, t.Phase, t.UpdatedAt.Local().Format(time.Stamp))
+	}
+	return nil
+}
+
+func (cl *client) show(id int64) error {
+	resp, err := cl.request(http.MethodGet, fmt.Sprintf("/game/show/%d/text", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkStatus(resp); err != nil {
+		return err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(cl.out, string(body))
+	return nil
+}
+
+func (cl *client) legalActions(id int64) ([]*action, error) {
+	var data struct{ Actions []*action }
+	err := cl.getJSON(fmt.Sprintf("/game/show/%d/actions/json", id), &data)
+	return data.Actions, err
+}
+
+func (cl *client) actions(id int64) error {
+	var data struct {
+		Actions     []*action
+		Unavailable []*unavailable
+	}
+	if err := cl.getJSON(fmt.Sprintf("/game/show/%d/actions/json", id), &data); err != nil {
+		return err
+	}
+
+	if len(data.Actions) == 0 {
+		fmt.Fprintln(cl.out, "You have no actions to take.  If you have taken your actions, finish your turn.")
+	}
+	for _, a := range data.Actions {
+		fmt.Fprintln(cl.out, a.Action)
+		if a.Prompt != "" {
+			fmt.Fprintln(cl.out, "  "+a.Prompt)
+		}
+		if a.Warning != "" {
+			fmt.Fprintln(cl.out, "  warning: "+a.Warning)
+		}
+		for _, f := range a.Fields {
+			fmt.Fprintln(cl.out, "  "+describe(f))
+		}
+	}
+
+	if len(data.Unavailable) > 0 {
+		fmt.Fprintln(cl.out, "\nUnavailable:")
+	}
+	for _, u := range data.Unavailable {
+		fmt.Fprintf(cl.out, "%s: %s\n", u.Action, strings.Join(u.Reasons, "; "))
+	}
+	return nil
+}
+
+func describe(f *field) string {
+	switch {
+	case f.Kind == "fixed":
+		return fmt.Sprintf("%s (set to %s)", f.Name, f.Value)
+	case f.Kind == "cards" && f.AutoPay:
+		return "cards=<coins of the cards played, e.g. 1,3, or auto to overpay the least> [keep=<coins of cards not to play when auto>]"
+	case f.Kind == "cards":
+		return "cards=<coins of the cards played, e.g. 1,3>"
+	case len(f.Options) > 0:
+		return fmt.Sprintf("%s=<%s> one of %s", f.Name, f.Kind, strings.Join(f.Options, ", "))
+	default:
+		return fmt.Sprintf("%s=<%s>", f.Name, f.Kind)
+	}
+}
+
+func (cl *client) do(id int64, name string, args []string) error {
+	if !contains(updateActions, name) {
+		return fmt.Errorf("unknown action %q; actions are %s", name, strings.Join(updateActions, ", "))
+	}
+
+	form := url.Values{"action": {name}}
+	if name != "reset" {
+		as, err := cl.legalActions(id)
+		if err != nil {
+			return err
+		}
+
+		values := make(map[string]string)
+		for _, arg := range args {
+			ss := strings.SplitN(arg, "=", 2)
+			if len(ss) != 2 {
+				return fmt.Errorf("invalid field %q; fields are given as name=value", arg)
+			}
+			values[ss[0]] = ss[1]
+		}
+
+		a, err := match(as, name, values)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range a.Fields {
+			key := f.Name
+			if f.Kind == "cards" {
+				key = "cards"
+			}
+
+			value, ok := values[key]
+			switch {
+			case f.Kind == "fixed":
+				form.Set(f.Name, f.Value)
+			case !ok:
+				return fmt.Errorf("%s requires %s", name, describe(f))
+			case f.Kind == "cards" && f.AutoPay && value == "auto":
+				form.Set(f.Name+"-auto-pay", "true")
+				counts, err := coinCounts(values["keep"])
+				if err != nil {
+					return err
+				}
+				for coins, count := range counts {
+					form.Set(fmt.Sprintf("%s-keep-coins%d", f.Name, coins+1), strconv.Itoa(count))
+				}
+			case f.Kind == "cards":
+				counts, err := coinCounts(value)
+				if err != nil {
+					return err
+				}
+				for coins, count := range counts {
+					form.Set(fmt.Sprintf("%s-coins%d", f.Name, coins+1), strconv.Itoa(count))
+				}
+			case len(f.Options) > 0 && !contains(f.Options, value):
+				return fmt.Errorf("invalid %s; %s", f.Name, describe(f))
+			default:
+				form.Set(f.Name, value)
+			}
+		}
+
+		if a.Reveals && !cl.yes && !cl.confirm(a.Warning) {
+			return errors.New("action not taken")
+		}
+	}
+
+	return cl.post(fmt.Sprintf("/game/show/%d", id), form, "")
+}
+
+// match returns the legal action of the given name.  Where the user holds several Emperor's
+// Reward cards permitting the action, the reward-card value selects among them.
+func match(as []*action, name string, values map[string]string) (*action, error) {
+	var found []*action
+	for _, a := range as {
+		if a.Action == name {
+			found = append(found, a)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%s is not presently a legal action; run actions to list them", name)
+	case 1:
+		return found[0], nil
+	}
+
+	for _, a := range found {
+		for _, f := range a.Fields {
+			if f.Kind == "fixed" && values[f.Name] == f.Value {
+				return a, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%s may be taken with several reward cards; select one with reward-card=<type>", name)
+}
+
+// coinCounts converts the coins of the cards played, such as 1,3, to the number of cards played
+// of each coin value.
+func coinCounts(value string) ([3]int, error) {
+	var counts [3]int
+	if value == "" || value == "0" {
+		return counts, nil
+	}
+
+	for _, s := range strings.Split(value, ",") {
+		coins, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || coins < 1 || coins > 3 {
+			return counts, fmt.Errorf("invalid card %q; cards are given by their coins, from 1 to 3", s)
+		}
+		counts[coins-1]++
+	}
+	return counts, nil
+}
+
+// confirm asks the user whether to go ahead despite the warning.
+func (cl *client) confirm(warning string) bool {
+	fmt.Fprintf(cl.out, "%s Continue? [y/N] ", warning)
+	answer, err := cl.in.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// post submits the form and reports the notices and errors of the response.  Where done is not
+// empty, the server redirects upon success, and done is reported instead.
+func (cl *client) post(path string, form url.Values, done string) error {
+	resp, err := cl.request(http.MethodPost, path, form)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if done != "" && resp.StatusCode == http.StatusSeeOther {
+		fmt.Fprintln(cl.out, done)
+		return nil
+	}
+	if err := checkStatus(resp); err != nil {
+		return err
+	}
+
+	var r result
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return err
+	}
+	for _, notice := range r.Notices {
+		fmt.Fprintln(cl.out, plain(notice))
+	}
+	if len(r.Errors) > 0 {
+		ss := make([]string, len(r.Errors))
+		for i, e := range r.Errors {
+			ss[i] = plain(e)
+		}
+		return errors.New(strings.Join(ss, "\n"))
+	}
+	return nil
+}
+
+func (cl *client) getJSON(path string, v interface{}) error {
+	resp, err := cl.request(http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := checkStatus(resp); err != nil {
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (cl *client) request(method, path string, form url.Values) (*http.Response, error) {
+	var body *strings.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	} else {
+		body = strings.NewReader("")
+	}
+
+	req, err := http.NewRequest(method, cl.server+cl.prefix+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Cookie", cl.cookie)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	return cl.http.Do(req)
+}
+
+func checkStatus(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized:
+		return errors.New("not logged in or session expired; run confucius-cli login <cookie>")
+	case resp.StatusCode == http.StatusSeeOther:
+		return errors.New("the server declined the request")
+	default:
+		return fmt.Errorf("server responded %s", resp.Status)
+	}
+}
+
+var tags = regexp.MustCompile(`<[^>]*>`)
+
+// plain converts the HTML of a notice or error to plain text.
+func plain(s string) string {
+	return strings.TrimSpace(html.UnescapeString(tags.ReplaceAllString(s, "")))
+}
+
+func sessionPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "confucius-cli", "session")
+}
+
+func envOr(key, value string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return value
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func plural(n int, one, other string) string {
+	if n == 1 {
+		return one
+	}
+	return other
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	testGameID = 7
+	testUserID = 1
+)
+
+func testGame() *memGame {
+	return &memGame{
+		ID:     testGameID,
+		Title:  "Test",
+		Round:  2,
+		Phase:  "Actions",
+		UserID: testUserID,
+		Board:  "Confucius #7: Test\n  Round 2, phase: Actions\n",
+		Actions: []*action{
+			{
+				Action: "bribe-official",
+				Fields: []*field{
+					{Name: "bribe-official", Kind: "official", Options: []string{"Hubu-3", "Bingbu-4"}},
+					{Name: "bribe-official", Kind: "cards", AutoPay: true},
+				},
+			},
+			{
+				Action:  "tutor-student",
+				Fields:  []*field{{Name: "tutor-student", Kind: "cards"}},
+				Reveals: true,
+				Warning: "This action reveals hidden information and cannot be undone.",
+			},
+			{
+				Action: "take-bribery-reward",
+				Fields: []*field{{Name: "reward-card", Kind: "fixed", Value: "4"}},
+			},
+			{
+				Action: "take-bribery-reward",
+				Fields: []*field{{Name: "reward-card", Kind: "fixed", Value: "6"}},
+			},
+			{Action: "pass"},
+		},
+		Unavailable: []*unavailable{{Action: "buy-junks", Reasons: []string{"you have no licenses"}}},
+		Rejects:     map[string]string{"pass": "You must use all of your action cubes before passing."},
+	}
+}
+
+// newTestClient returns a client of a local server keeping the games in memory, and the output of
+// the client.  The client is logged in, unless session is empty.
+func newTestClient(t *testing.T, s *memStore, session, input string) (*client, *bytes.Buffer) {
+	t.Helper()
+
+	srv := newLocalServer(s)
+	t.Cleanup(srv.Close)
+
+	dir, err := ioutil.TempDir("", "confucius-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "session")
+	if session != "" {
+		if err := ioutil.WriteFile(path, []byte(session), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := new(bytes.Buffer)
+	return newClient(srv.URL, "confucius", path, strings.NewReader(input), out), out
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name    string
+		cookie  string
+		wantErr bool
+		wantOut string
+	}{
+		{"signed in", "session=1", false, "Logged in.  1 game awaiting your turn.\n"},
+		{"other user", "session=2", false, "Logged in.  0 games awaiting your turn.\n"},
+		{"expired", "session=expired", true, ""},
+		{"malformed", "session", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, out := newTestClient(t, newMemStore(testGame()), "", "")
+
+			err := cl.run("login", []string{tt.cookie})
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("error: got %v, want error %v", err, tt.wantErr)
+			}
+			if got := out.String(); got != tt.wantOut {
+				t.Errorf("output: got %q, want %q", got, tt.wantOut)
+			}
+
+			saved, err := ioutil.ReadFile(cl.session)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("session saved after failed login")
+				}
+				return
+			}
+			if string(saved) != tt.cookie {
+				t.Errorf("saved session: got %q, want %q", saved, tt.cookie)
+			}
+		})
+	}
+}
+
+func TestNotLoggedIn(t *testing.T) {
+	cl, _ := newTestClient(t, newMemStore(testGame()), "", "")
+	if err := cl.run("games", nil); err == nil || !strings.Contains(err.Error(), "not logged in") {
+		t.Errorf("error: got %v, want not logged in", err)
+	}
+}
+
+func TestShowCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut []string
+	}{
+		{"games", []string{"games"}, []string{"7\tTest\tround 2, Actions\t"}},
+		{"show", []string{"show", "7"}, []string{"Confucius #7: Test\n  Round 2, phase: Actions\n"}},
+		{"actions", []string{"actions", "7"}, []string{
+			"bribe-official\n  bribe-official=<official> one of Hubu-3, Bingbu-4\n  cards=<coins",
+			"tutor-student\n  warning: This action reveals",
+			"take-bribery-reward\n  reward-card (set to 4)\n",
+			"\nUnavailable:\nbuy-junks: you have no licenses\n",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, out := newTestClient(t, newMemStore(testGame()), "session=1", "")
+			if err := cl.run(tt.args[0], tt.args[1:]); err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output %q does not contain %q", out.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		wantForm url.Values
+		wantOut  string
+		wantErr  string
+	}{
+		{
+			name: "cards played",
+			args: []string{"bribe-official", "bribe-official=Hubu-3", "cards=1,3,3"},
+			wantForm: url.Values{
+				"action":                {"bribe-official"},
+				"bribe-official":        {"Hubu-3"},
+				"bribe-official-coins1": {"1"},
+				"bribe-official-coins2": {"0"},
+				"bribe-official-coins3": {"2"},
+			},
+			wantOut: "bribe-official & done.\n",
+		},
+		{
+			name: "cards chosen by the server",
+			args: []string{"bribe-official", "bribe-official=Bingbu-4", "cards=auto", "keep=3"},
+			wantForm: url.Values{
+				"action":                     {"bribe-official"},
+				"bribe-official":             {"Bingbu-4"},
+				"bribe-official-auto-pay":    {"true"},
+				"bribe-official-keep-coins1": {"0"},
+				"bribe-official-keep-coins2": {"0"},
+				"bribe-official-keep-coins3": {"1"},
+			},
+			wantOut: "bribe-official & done.\n",
+		},
+		{
+			name: "reward card selected",
+			args: []string{"take-bribery-reward", "reward-card=6"},
+			wantForm: url.Values{
+				"action":      {"take-bribery-reward"},
+				"reward-card": {"6"},
+			},
+			wantOut: "take-bribery-reward & done.\n",
+		},
+		{
+			name:  "reveal confirmed",
+			args:  []string{"tutor-student", "cards=2"},
+			input: "y\n",
+			wantForm: url.Values{
+				"action":               {"tutor-student"},
+				"tutor-student-coins1": {"0"},
+				"tutor-student-coins2": {"1"},
+				"tutor-student-coins3": {"0"},
+			},
+			wantOut: "This action reveals hidden information and cannot be undone. Continue? [y/N] tutor-student & done.\n",
+		},
+		{
+			name:    "reveal declined",
+			args:    []string{"tutor-student", "cards=2"},
+			input:   "n\n",
+			wantErr: "action not taken",
+		},
+		{
+			name:    "reward card not selected",
+			args:    []string{"take-bribery-reward"},
+			wantErr: "select one with reward-card=<type>",
+		},
+		{
+			name:    "field missing",
+			args:    []string{"bribe-official", "cards=1"},
+			wantErr: "bribe-official requires bribe-official=<official>",
+		},
+		{
+			name:    "invalid option",
+			args:    []string{"bribe-official", "bribe-official=Gongbu-1", "cards=1"},
+			wantErr: "invalid bribe-official",
+		},
+		{
+			name:    "invalid card",
+			args:    []string{"bribe-official", "bribe-official=Hubu-3", "cards=4"},
+			wantErr: `invalid card "4"`,
+		},
+		{
+			name:    "illegal action",
+			args:    []string{"buy-junks"},
+			wantErr: "buy-junks is not presently a legal action",
+		},
+		{
+			name:    "unknown action",
+			args:    []string{"fly"},
+			wantErr: `unknown action "fly"`,
+		},
+		{
+			name:    "rejected by the server",
+			args:    []string{"pass"},
+			wantErr: "You must use all of your action cubes before passing.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newMemStore(testGame())
+			cl, out := newTestClient(t, s, "session=1", tt.input)
+
+			err := cl.run("do", append([]string{"7"}, tt.args...))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error: got %v, want %q", err, tt.wantErr)
+				}
+				if taken := s.game(testGameID).Taken; len(taken) != 0 {
+					t.Errorf("actions taken: got %v, want none", taken)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			taken := s.game(testGameID).Taken
+			if len(taken) != 1 || !reflect.DeepEqual(taken[0], tt.wantForm) {
+				t.Errorf("actions taken: got %v, want %v", taken, tt.wantForm)
+			}
+			if got := out.String(); got != tt.wantOut {
+				t.Errorf("output: got %q, want %q", got, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestTurn(t *testing.T) {
+	s := newMemStore(testGame())
+	cl, out := newTestClient(t, s, "session=1", "")
+
+	steps := []struct {
+		args      []string
+		wantOut   string
+		wantTaken int
+	}{
+		{[]string{"do", "7", "bribe-official", "bribe-official=Hubu-3", "cards=1"}, "bribe-official & done.\n", 1},
+		{[]string{"reset", "7"}, "Turn reset.\n", 0},
+		{[]string{"do", "7", "bribe-official", "bribe-official=Hubu-3", "cards=1"}, "bribe-official & done.\n", 1},
+		{[]string{"undo", "7"}, "Turn undone.\n", 0},
+		{[]string{"do", "7", "bribe-official", "bribe-official=Hubu-3", "cards=1"}, "bribe-official & done.\n", 1},
+		{[]string{"finish", "7"}, "Turn finished.\n", 1},
+		{[]string{"games"}, "No games await your turn.\n", 1},
+	}
+
+	for _, step := range steps {
+		out.Reset()
+		if err := cl.run(step.args[0], step.args[1:]); err != nil {
+			t.Fatalf("%v: %v", step.args, err)
+		}
+		if got := out.String(); got != step.wantOut {
+			t.Errorf("%v: output: got %q, want %q", step.args, got, step.wantOut)
+		}
+		if got := len(s.game(testGameID).Taken); got != step.wantTaken {
+			t.Errorf("%v: actions taken: got %d, want %d", step.args, got, step.wantTaken)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const testPrefix = "/confucius"
+
+// memGame is a game kept by the local server: its board, the legal actions of its current player,
+// and the forms of the actions taken during the current turn.
+type memGame struct {
+	ID          int64
+	Title       string
+	Round       int
+	Phase       string
+	UserID      int64
+	Board       string
+	Actions     []*action
+	Unavailable []*unavailable
+	Rejects     map[string]string
+	Taken       []url.Values
+	Finished    bool
+}
+
+// memStore keeps the games of the local server in memory, in place of the datastore.
+type memStore struct {
+	sync.Mutex
+	games map[int64]*memGame
+}
+
+func newMemStore(gs ...*memGame) *memStore {
+	s := &memStore{games: make(map[int64]*memGame)}
+	for _, g := range gs {
+		s.games[g.ID] = g
+	}
+	return s
+}
+
+func (s *memStore) game(id int64) *memGame {
+	s.Lock()
+	defer s.Unlock()
+	return s.games[id]
+}
+
+// newLocalServer serves the routes used by the client, as the game server does, from the games of
+// the store.  A user is signed in by the cookie session=<user id>.  The server stands in for the
+// game server only in serving the actions of the games; TestContract checks that the client handles
+// the actions JSON of the real server, whose tests take the forms the client posts.
+func newLocalServer(s *memStore) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(testPrefix+"/turns/json", func(w http.ResponseWriter, r *http.Request) {
+		uid, ok := signedIn(r)
+		if !ok {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"Error": "You must be logged in."})
+			return
+		}
+
+		s.Lock()
+		defer s.Unlock()
+		ts := []*turn{}
+		for _, g := range s.games {
+			if g.UserID == uid && !g.Finished {
+				ts = append(ts, &turn{ID: g.ID, Title: g.Title, Round: g.Round, Phase: g.Phase, UpdatedAt: time.Now()})
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"Games": ts})
+	})
+
+	mux.HandleFunc(testPrefix+"/game/", func(w http.ResponseWriter, r *http.Request) {
+		uid, _ := signedIn(r)
+		ss := strings.Split(strings.TrimPrefix(r.URL.Path, testPrefix+"/game/"), "/")
+		if len(ss) < 2 {
+			http.NotFound(w, r)
+			return
+		}
+		id, err := strconv.ParseInt(ss[1], 10, 64)
+		g := s.game(id)
+		if err != nil || g == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		s.Lock()
+		defer s.Unlock()
+		switch route := ss[0] + "/" + strings.Join(ss[2:], "/"); {
+		case r.Method == http.MethodGet && route == "show/text":
+			fmt.Fprint(w, g.Board)
+		case r.Method == http.MethodGet && route == "show/actions/json":
+			data := map[string]interface{}{"Actions": []*action{}, "Unavailable": g.Unavailable}
+			if g.UserID == uid && !g.Finished {
+				data["Actions"] = g.Actions
+			}
+			writeJSON(w, http.StatusOK, data)
+		case r.Method == http.MethodPost && route == "show/":
+			g.update(w, r, uid)
+		case r.Method == http.MethodPost && route == "finish/":
+			g.Finished = true
+			http.Redirect(w, r, testPrefix+"/game/show/"+ss[1], http.StatusSeeOther)
+		case r.Method == http.MethodPost && route == "undo/":
+			g.Taken = nil
+			http.Redirect(w, r, testPrefix+"/game/show/"+ss[1], http.StatusSeeOther)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+// update takes the action of the form, reporting notices and errors as the game server does for
+// requests accepting JSON.
+func (g *memGame) update(w http.ResponseWriter, r *http.Request, uid int64) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	a := r.PostForm.Get("action")
+	switch {
+	case g.UserID != uid:
+		writeResult(w, nil, []string{"Only the current player may perform this action."})
+	case g.Rejects[a] != "":
+		writeResult(w, nil, []string{g.Rejects[a]})
+	case a == "reset":
+		g.Taken = nil
+		writeResult(w, []string{"<div>Turn reset.</div>"}, nil)
+	default:
+		g.Taken = append(g.Taken, r.PostForm)
+		writeResult(w, []string{"<div>" + html.EscapeString(a) + " &amp; done.</div>"}, nil)
+	}
+}
+
+func signedIn(r *http.Request) (int64, bool) {
+	cookie, err := r.Cookie("session")
+	if err != nil {
+		return 0, false
+	}
+	uid, err := strconv.ParseInt(cookie.Value, 10, 64)
+	return uid, err == nil
+}
+
+func writeResult(w http.ResponseWriter, notices, errors []string) {
+	writeJSON(w, http.StatusOK, map[string][]string{"Notices": notices, "Errors": errors})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
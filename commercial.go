@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -39,9 +38,9 @@ func (g *Game) commercial(c *gin.Context, cu *user.User) (string, game.ActionTyp
 
 	// Take Cards and Create Action Object for logging
 	cv := cds.Coins()
-	ncds := make(ConCards, cv+1)
-	for i := range ncds {
-		ncds[i] = g.DrawConCard()
+	ncds := ConCards{}
+	for i := 0; i <= cv; i++ {
+		ncds.Append(g.DrawConCard())
 	}
 	cp.ConCardHand.Append(ncds...)
 
@@ -50,7 +49,7 @@ func (g *Game) commercial(c *gin.Context, cu *user.User) (string, game.ActionTyp
 
 	// Set flash message
 	restful.AddNoticef(c, string(entry.HTML()))
-	return "", game.Cache, nil
+	return "", g.commit(cp), nil
 }
 
 type commercialEntry struct {
@@ -71,10 +70,11 @@ func (p *Player) newCommercialEntry(cds, ncds ConCards) *commercialEntry {
 }
 
 func (e *commercialEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
 	coins := e.Played.Coins()
-	return restful.HTML("%s spent %d Confucius %s having %d %s to receive %d cards of commercial income.",
-		e.Player().Name(), length, pluralize("card", length), coins, pluralize("coin", coins), len(e.Received))
+	return l.HTML("entry.commercial-income",
+		e.Player().Name(), length, l.Plural("card", length), coins, l.Plural("coin", coins), len(e.Received))
 }
 
 func (g *Game) validateCommercial(c *gin.Context, cu *user.User) (ConCards, int, error) {
@@ -94,17 +94,26 @@ func (g *Game) validateCommercial(c *gin.Context, cu *user.User) (ConCards, int,
 	cp, cv := g.CurrentPlayer(), cds.Coins()
 	switch {
 	case cp.TakenCommercial:
-		return nil, 0, sn.NewVError("You have already taken the commercial income action this round.")
+		return nil, 0, g.vError("error.already-taken-commercial-income-action-round")
 	case cv > 4:
-		return nil, 0, sn.NewVError("You may only pay up to 4 coins. You paid %d coins.", cv)
+		return nil, 0, g.vError("error.may-only-pay-up-4-coins", cv)
 	default:
 		return cds, cbs, nil
 	}
 }
 
 func (g *Game) EnableCommercial(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.inActionsOrImperialFavourPhase() && g.CurrentPlayer() != nil &&
-		!cp.PerformedAction && g.IsCurrentPlayer(cu) &&
-		cp.hasEnoughCubesFor(CommercialSpace) && !cp.TakenCommercial && cp.hasConCards()
+	return g.CommercialReasons(cu).None()
+}
+
+// CommercialReasons returns why the user cu may not take a commercial action.
+func (g *Game) CommercialReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, CommercialSpace).
+		require(!cp.TakenCommercial, "reason.commercial-taken").
+		require(cp.hasConCards(), "reason.no-con-cards")
+	return r.reasons
 }
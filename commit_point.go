@@ -0,0 +1,87 @@
+package confucius
+
+import (
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/restful"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// revealingActions lists the actions that draw or reveal hidden information.  Such actions are
+// commit points: the game is saved once they are taken, so they cannot be undone.  Voyages are
+// commit points only where they complete, drawing a card or taking a chit.
+var revealingActions = map[string]bool{
+	"tax-income":          true,
+	"commercial":          true,
+	"start-voyage":        true,
+	"choose-distant-land": true,
+	"take-cash":           true,
+}
+
+// Reveals reports whether the action draws or reveals hidden information, and so cannot be undone.
+func (g *Game) Reveals(action string) bool {
+	return revealingActions[action]
+}
+
+// CommitPoint records the last action of the turn of a player that drew or revealed hidden
+// information.  LogIndex is the index of the log entry reporting the action.
+type CommitPoint struct {
+	PlayerID int
+	LogIndex int
+}
+
+// commit makes the action just taken by the player a commit point, returning the action type by
+// which the game is saved rather than cached.
+func (g *Game) commit(p *Player) game.ActionType {
+	g.CommitPoint = &CommitPoint{PlayerID: p.ID(), LogIndex: len(g.Log) - 1}
+	return game.Save
+}
+
+// commitIfDrawn makes the voyage action just taken by the player a commit point where it completed
+// a voyage drawing an Emperor's Reward card or taking the chit of a distant land, given the count
+// of those left by voyagesLeft before the action.  Otherwise, the game is cached, as the action
+// may be undone.
+func (g *Game) commitIfDrawn(p *Player, left int) game.ActionType {
+	if g.voyagesLeft() == left {
+		return game.Cache
+	}
+	return g.commit(p)
+}
+
+// voyagesLeft returns the number of Emperor's Reward cards and distant land chits left to be drawn
+// or taken by completing voyages.
+func (g *Game) voyagesLeft() int {
+	left := len(g.EmperorDeck)
+	for _, land := range g.DistantLands {
+		if land.Chit != NoChit {
+			left++
+		}
+	}
+	return left
+}
+
+// committedBy returns the commit point of the present turn of the player, if any.
+func (g *Game) committedBy(p *Player) *CommitPoint {
+	if g.CommitPoint == nil || p == nil || g.CommitPoint.PlayerID != p.ID() {
+		return nil
+	}
+	return g.CommitPoint
+}
+
+// stopRewind logs an attempt by the user cu to undo or reset a turn across a commit point, notifying
+// the user that the turn rewinds only to the commit point, and reports whether there was one.
+func (client *Client) stopRewind(c *gin.Context, g *Game, cu *user.User, how string) bool {
+	if cu == nil {
+		return false
+	}
+
+	cp := g.committedBy(g.PlayerByUserID(cu.ID()))
+	if cp == nil {
+		return false
+	}
+
+	client.Log.Warningf("game %d: user %d attempted to %s turn across commit point at log entry %d",
+		g.ID(), cu.ID(), how, cp.LogIndex)
+	restful.AddNoticef(c, "%s", g.Locale().Sprintf("notice.rewind-stopped", g.Log[cp.LogIndex].HTML()))
+	return true
+}
@@ -0,0 +1,111 @@
+package confucius
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/sn"
+)
+
+func TestCommitPoints(t *testing.T) {
+	voyage := url.Values{"action": {"start-voyage"}, "start-voyage-coins1": {"1"}, "start-voyage-coins2": {"0"},
+		"start-voyage-coins3": {"0"}, "junks": {"1"}}
+	tests := []struct {
+		name       string
+		setup      func(g *Game, cp *Player)
+		form       url.Values
+		wantCommit bool
+	}{
+		{
+			name:       "tax income",
+			form:       url.Values{"action": {"tax-income"}},
+			wantCommit: true,
+		},
+		{
+			name: "commercial",
+			form: url.Values{"action": {"commercial"}, "commercial-coins1": {"1"}, "commercial-coins2": {"0"},
+				"commercial-coins3": {"0"}},
+			wantCommit: true,
+		},
+		{
+			name: "bribe official",
+			form: url.Values{"action": {"bribe-official"}, "bribe-official": {"Hubu-3"}, "bribe-official-auto-pay": {"true"}},
+		},
+		{
+			name: "voyage under way",
+			setup: func(g *Game, cp *Player) {
+				cp.Junks, cp.OnVoyage = 1, 0
+			},
+			form: voyage,
+		},
+		{
+			name: "voyage awaiting choice of distant land",
+			setup: func(g *Game, cp *Player) {
+				cp.Junks, cp.OnVoyage = 1, 4
+			},
+			form: voyage,
+		},
+		{
+			name: "voyage to the last distant land",
+			setup: func(g *Game, cp *Player) {
+				cp.Junks, cp.OnVoyage = 1, 4
+				for _, land := range g.DistantLands[1:] {
+					land.SetPlayers(Players{cp})
+				}
+			},
+			form:       voyage,
+			wantCommit: true,
+		},
+		{
+			name: "voyage to a distant land without chit or card",
+			setup: func(g *Game, cp *Player) {
+				cp.Junks, cp.OnVoyage = 1, 4
+				for _, land := range g.DistantLands[1:] {
+					land.SetPlayers(Players{cp})
+				}
+				g.DistantLands[0].Chit = NoChit
+				g.EmperorDeck = nil
+			},
+			form: voyage,
+		},
+		{
+			name: "distant land chosen",
+			setup: func(g *Game, cp *Player) {
+				cp.PendingVoyages = 1
+			},
+			form:       url.Values{"action": {"choose-distant-land"}, "distant-land": {"0"}},
+			wantCommit: true,
+		},
+	}
+
+	client := &Client{Client: &sn.Client{Log: new(log.Logger)}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g, cu := newContractGame(t)
+			cp := g.CurrentPlayer()
+			if tt.setup != nil {
+				tt.setup(g, cp)
+			}
+			postForm(c, tt.form)
+
+			_, actionType, err := g.Update(c, cu)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := game.Cache
+			if tt.wantCommit {
+				want = game.Save
+			}
+			if actionType != want {
+				t.Errorf("action type: got %v, want %v", actionType, want)
+			}
+			for _, how := range []string{"undo", "reset"} {
+				if got := client.stopRewind(c, g, cu, how); got != tt.wantCommit {
+					t.Errorf("%s stopped: got %v, want %v", how, got, tt.wantCommit)
+				}
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ func NewConDeck(nplayers int) ConCards {
 	for i := 0; i < 22-nplayers; i++ {
 		deck = append(deck, &ConCard{Coins: 1}, &ConCard{Coins: 2}, &ConCard{Coins: 3})
 	}
+	deck.Shuffle()
 	return deck
 }
 
@@ -44,11 +45,14 @@ func (cds *ConCards) Append(cards ...*ConCard) {
 	*cds = cds.AppendS(cards...)
 }
 
+// AppendS appends the cards, skipping nil cards drawn from an exhausted deck.
 func (cds ConCards) AppendS(cards ...*ConCard) ConCards {
-	if len(cards) == 0 {
-		return cds
+	for _, card := range cards {
+		if card != nil {
+			cds = append(cds, card)
+		}
 	}
-	return append(cds, cards...)
+	return cds
 }
 
 func (cds *ConCards) Remove(cards ...*ConCard) {
@@ -83,11 +87,17 @@ func (cds *ConCards) Draw() *ConCard {
 	return card
 }
 
+// DrawS draws the top card of the deck, returning nil if the deck is empty.
 func (cds ConCards) DrawS() (ConCards, *ConCard) {
-	i := sn.MyRand.Intn(len(cds))
-	card := cds[i]
-	cs := cds.removeAt(i)
-	return cs, card
+	if len(cds) == 0 {
+		return cds, nil
+	}
+	return cds[1:], cds[0]
+}
+
+// Shuffle shuffles the cards in place.
+func (cds ConCards) Shuffle() {
+	sn.MyRand.Shuffle(len(cds), func(i, j int) { cds[i], cds[j] = cds[j], cds[i] })
 }
 
 func (cds ConCards) Licenses() int {
@@ -0,0 +1,91 @@
+package confucius
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"math/rand"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/SlothNinja/sn"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// The contract between the server and confucius-cli is kept in the testdata of the client: the
+// actions JSON served for a fixed game, and the forms the client posts for each of its actions.
+// The client is tested against the actions, and the server against the forms.
+var (
+	update      = flag.Bool("update", false, "update the contract kept in the testdata of confucius-cli")
+	contractDir = filepath.Join("cmd", "confucius-cli", "testdata")
+)
+
+// contractForm is a form posted by confucius-cli, with the arguments of the do command sending it.
+type contractForm struct {
+	Args []string
+	Form url.Values
+}
+
+// newContractGame returns a game in the actions phase whose current player holds Emperor's
+// Reward cards, and that player.  The game is the same on every call.
+func newContractGame(t *testing.T) (*gin.Context, *Game, *user.User) {
+	t.Helper()
+
+	sn.MyRand = rand.New(rand.NewSource(1))
+	c, g := newTestGame(t, 3)
+	cp := g.CurrentPlayer()
+	cp.EmperorHand = EmperorCards{{Type: Cash}, {Type: HubuBribery}, {Type: AnyBribery1}}
+	return c, g, g.Users[cp.ID()]
+}
+
+func TestContractActions(t *testing.T) {
+	_, g, cu := newContractGame(t)
+	got, err := json.MarshalIndent(g.actionsData(cu), "", "\t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(contractDir, "actions.json")
+	if *update {
+		if err := ioutil.WriteFile(path, append(got, '\n'), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(got), bytes.TrimSpace(want)) {
+		t.Errorf("actions JSON differs from %s; if the change is intended, run go test -update and "+
+			"the tests of confucius-cli", path)
+	}
+}
+
+func TestContractForms(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.Join(contractDir, "forms.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var forms []*contractForm
+	if err := json.Unmarshal(b, &forms); err != nil {
+		t.Fatal(err)
+	}
+	if len(forms) == 0 {
+		t.Fatal("no forms")
+	}
+
+	for _, f := range forms {
+		t.Run(strings.Join(f.Args, " "), func(t *testing.T) {
+			c, g, cu := newContractGame(t)
+			postForm(c, f.Form)
+
+			if _, _, err := g.Update(c, cu); err != nil {
+				t.Errorf("form %v: %v", f.Form, err)
+			}
+		})
+	}
+}
@@ -7,11 +7,11 @@ import (
 	"html/template"
 	"net/http"
 	"strconv"
+	"time"
 
 	"cloud.google.com/go/datastore"
 	"github.com/SlothNinja/codec"
 	"github.com/SlothNinja/color"
-	"github.com/SlothNinja/contest"
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/mlog"
@@ -65,7 +65,54 @@ func (g *Game) Update(c *gin.Context, cu *user.User) (string, game.ActionType, e
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	switch a := c.PostForm("action"); a {
+	a := c.PostForm("action")
+	cp := g.CurrentPlayer()
+	template, actionType, err := g.perform(c, cu, a)
+	if err == nil && cp != nil && (actionType == game.Cache || actionType == game.Save) && playerActions[a] {
+		g.emit(&ActionTaken{PlayerID: cp.ID(), Action: a})
+	}
+	return template, actionType, err
+}
+
+// playerActions are the actions performed by players, as opposed to those resetting a turn or
+// editing the game as an admin.
+var playerActions = map[string]bool{
+	"bribe-official":          true,
+	"secure-official":         true,
+	"buy-gift":                true,
+	"give-gift":               true,
+	"nominate-student":        true,
+	"force-exam":              true,
+	"transfer-influence":      true,
+	"temp-transfer-influence": true,
+	"move-junks":              true,
+	"replace-student":         true,
+	"swap-officials":          true,
+	"redeploy-army":           true,
+	"replace-influence":       true,
+	"place-student":           true,
+	"buy-junks":               true,
+	"start-voyage":            true,
+	"choose-distant-land":     true,
+	"commercial":              true,
+	"tax-income":              true,
+	"recruit-army":            true,
+	"invade-land":             true,
+	"no-action":               true,
+	"pass":                    true,
+	"take-cash":               true,
+	"take-gift":               true,
+	"take-extra-action":       true,
+	"take-bribery-reward":     true,
+	"avenge-emperor":          true,
+	"take-army":               true,
+	"discard":                 true,
+	"choose-chief-minister":   true,
+	"tutor-student":           true,
+}
+
+func (g *Game) perform(c *gin.Context, cu *user.User, a string) (string, game.ActionType, error) {
+	switch a {
 	case "bribe-official":
 		return g.bribeOfficial(c, cu)
 	case "secure-official":
@@ -98,6 +145,8 @@ func (g *Game) Update(c *gin.Context, cu *user.User) (string, game.ActionType, e
 		return g.buyJunks(c, cu)
 	case "start-voyage":
 		return g.startVoyage(c, cu)
+	case "choose-distant-land":
+		return g.chooseDistantLand(c, cu)
 	case "commercial":
 		return g.commercial(c, cu)
 	case "tax-income":
@@ -151,7 +200,7 @@ func (g *Game) Update(c *gin.Context, cu *user.User) (string, game.ActionType, e
 		//	case "distant-land":
 		//		return g.adminDistantLand(c)
 	default:
-		return "confucius/flash_notice", game.None, sn.NewVError("%v is not a valid action.", a)
+		return "confucius/flash_notice", game.None, g.vError("error.not-valid-action", a)
 	}
 }
 
@@ -199,6 +248,13 @@ func (client *Client) show(prefix string) gin.HandlerFunc {
 			return
 		}
 
+		mod, err := client.getModeration(c, id)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
 		cu, err := client.User.Current(c)
 		if err != nil {
 			client.Log.Debugf(err.Error())
@@ -216,7 +272,10 @@ func (client *Client) show(prefix string) gin.HandlerFunc {
 			"Game":       gameFrom(c),
 			"IsAdmin":    cu.IsAdmin(),
 			"Admin":      game.AdminFrom(c),
-			"MessageLog": ml,
+			"MessageLog": mod.moderated(ml, cu),
+			"Unread":     client.unreadDiplomacy(c, gameFrom(c), cu),
+			"Summary":    gameFrom(c).SinceLastTurn(cu),
+			"Stalled":    gameFrom(c).Stalled(),
 			"ColorMap":   color.MapFrom(c),
 			"Notices":    notices,
 			"Errors":     errors,
@@ -247,6 +306,19 @@ func (client *Client) addMessage(prefix string) gin.HandlerFunc {
 			return
 		}
 
+		mod, err := client.getModeration(c, id)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		err = mod.validateMessage(ml, cu, c.PostForm("message"))
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+
 		m := ml.AddMessage(cu, c.PostForm("message"))
 
 		_, err = client.MLog.Put(c, id, ml)
@@ -298,16 +370,21 @@ func (client *Client) update(prefix string) gin.HandlerFunc {
 			err := client.save(c, g, cu)
 			if err != nil {
 				client.Log.Errorf("%s", err)
-				restful.AddErrorf(c, "Controller#Update Save Error: %s", err)
+				restful.AddErrorf(c, "%s", g.Locale().Sprintf("error.save-failed", err))
 				c.Redirect(http.StatusSeeOther, showPath(c, prefix))
 				return
 			}
 		case actionType == game.Undo:
 			mkey := g.UndoKey(cu)
 			client.Cache.Delete(mkey)
+		case actionType == game.Reset:
+			client.stopRewind(c, g, cu, "reset")
+			client.Cache.Delete(g.UndoKey(cu))
 		}
 
 		switch jData := jsonFrom(c); {
+		case wantsJSON(c):
+			c.JSON(http.StatusOK, gin.H{"Notices": restful.NoticesFrom(c), "Errors": restful.ErrorsFrom(c)})
 		case jData != nil && template == "json":
 			c.JSON(http.StatusOK, jData)
 		case template == "":
@@ -367,7 +444,7 @@ func (client *Client) save(c *gin.Context, g *Game, cu *user.User) error {
 		}
 
 		if oldG.UpdatedAt != g.UpdatedAt {
-			return fmt.Errorf("Game state changed unexpectantly.  Try again.")
+			return g.vError("error.game-state-changed")
 		}
 
 		err = g.encode(c)
@@ -383,10 +460,19 @@ func (client *Client) save(c *gin.Context, g *Game, cu *user.User) error {
 		client.Cache.Delete(g.UndoKey(cu))
 		return nil
 	})
+	if err == nil {
+		client.dispatch(c, g)
+	}
 	return err
 }
 
-func (client *Client) saveWith(c *gin.Context, g *Game, cu *user.User, ks []*datastore.Key, es []interface{}) error {
+// txPut returns further entities to be put by the transaction saving a game.  It may run more than
+// once, as the transaction is retried.
+type txPut func(tx *datastore.Transaction) ([]*datastore.Key, []interface{}, error)
+
+// saveWith saves the game with the entities, and with those of the puts, in a single transaction.
+func (client *Client) saveWith(c *gin.Context, g *Game, cu *user.User, ks []*datastore.Key, es []interface{},
+	puts ...txPut) error {
 	_, err := client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
 		oldG := New(c, g.ID())
 		err := tx.Get(oldG.Key, oldG.Header)
@@ -395,7 +481,7 @@ func (client *Client) saveWith(c *gin.Context, g *Game, cu *user.User, ks []*dat
 		}
 
 		if oldG.UpdatedAt != g.UpdatedAt {
-			return fmt.Errorf("Game state changed unexpectantly.  Try again.")
+			return g.vError("error.game-state-changed")
 		}
 
 		err = g.encode(c)
@@ -403,8 +489,15 @@ func (client *Client) saveWith(c *gin.Context, g *Game, cu *user.User, ks []*dat
 			return err
 		}
 
-		ks = append(ks, g.Key)
-		es = append(es, g.Header)
+		ks := append(append([]*datastore.Key{}, ks...), g.Key)
+		es := append(append([]interface{}{}, es...), g.Header)
+		for _, put := range puts {
+			pks, pes, err := put(tx)
+			if err != nil {
+				return err
+			}
+			ks, es = append(ks, pks...), append(es, pes...)
+		}
 
 		_, err = tx.PutMulti(ks, es)
 		if err != nil {
@@ -414,6 +507,9 @@ func (client *Client) saveWith(c *gin.Context, g *Game, cu *user.User, ks []*dat
 		client.Cache.Delete(g.UndoKey(cu))
 		return nil
 	})
+	if err == nil {
+		client.dispatch(c, g)
+	}
 	return err
 }
 
@@ -474,19 +570,6 @@ func (g *Game) encode(cx *gin.Context) error {
 // 	return memcache.Set(c, item)
 // }
 
-func wrap(s *user.Stats, cs []*contest.Contest) ([]*datastore.Key, []interface{}) {
-	l := len(cs) + 1
-	es := make([]interface{}, l)
-	ks := make([]*datastore.Key, l)
-	es[0] = s
-	ks[0] = s.Key
-	for i, c := range cs {
-		es[i+1] = c
-		ks[i+1] = c.Key
-	}
-	return ks, es
-}
-
 func showPath(c *gin.Context, prefix string) string {
 	return fmt.Sprintf("/%s/game/show/%s", prefix, c.Param("hid"))
 }
@@ -507,7 +590,7 @@ func (client *Client) undo(prefix string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		client.Log.Debugf(msgEnter)
 		defer client.Log.Debugf(msgExit)
-		c.Redirect(http.StatusSeeOther, showPath(c, prefix))
+		defer c.Redirect(http.StatusSeeOther, showPath(c, prefix))
 
 		g := gameFrom(c)
 		if g == nil {
@@ -519,6 +602,13 @@ func (client *Client) undo(prefix string) gin.HandlerFunc {
 			client.Log.Errorf(err.Error())
 			return
 		}
+		if client.stopRewind(c, g, cu, "undo") {
+			session := sessions.Default(c)
+			session.AddFlash(restful.NoticesFrom(c), "_notices")
+			if err := session.Save(); err != nil {
+				client.Log.Errorf(err.Error())
+			}
+		}
 		mkey := g.UndoKey(cu)
 		client.Cache.Delete(mkey)
 	}
@@ -613,6 +703,7 @@ func (client *Client) newAction(prefix string) gin.HandlerFunc {
 			"VersionID": sn.VersionID(),
 			"CUser":     cu,
 			"Game":      g,
+			"Variants":  GameVariants(),
 		})
 	}
 }
@@ -646,6 +737,17 @@ func (client *Client) create(prefix string) gin.HandlerFunc {
 			return
 		}
 
+		// Solo games need no other players, so start at once.
+		solo := g.HasVariant(SoloVariant)
+		if solo {
+			err = g.Start(c)
+			if err != nil {
+				client.Log.Errorf(err.Error())
+				c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+				return
+			}
+		}
+
 		err = g.encode(c)
 		if err != nil {
 			client.Log.Errorf(err.Error())
@@ -661,6 +763,7 @@ func (client *Client) create(prefix string) gin.HandlerFunc {
 		}
 
 		k := ks[0]
+		g.Key = k
 
 		_, err = client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
 			m := mlog.New(k.ID)
@@ -675,8 +778,13 @@ func (client *Client) create(prefix string) gin.HandlerFunc {
 			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
 			return
 		}
+		client.dispatch(c, g)
 
-		restful.AddNoticef(c, "<div>%s created.</div>", g.Title)
+		restful.AddNoticef(c, "<div>%s</div>", localeFrom(c).Sprintf("notice.game-created", g.Title))
+		if solo {
+			c.Redirect(http.StatusSeeOther, fmt.Sprintf("/%s/game/show/%d", prefix, k.ID))
+			return
+		}
 		c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
 	}
 }
@@ -689,7 +797,7 @@ func (client *Client) accept(prefix string) gin.HandlerFunc {
 		g := gameFrom(c)
 		if g == nil {
 			client.Log.Errorf("game not found")
-			restful.AddErrorf(c, "game not found")
+			restful.AddErrorf(c, "%s", localeFrom(c).Sprintf("error.game-not-found"))
 			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
 			return
 		}
@@ -829,6 +937,7 @@ func (client *Client) mcGet(c *gin.Context, g *Game, cu *user.User) error {
 		return fmt.Errorf("item not a *Game")
 	}
 	g2.SetCTX(c)
+	g2.locale = localeFrom(c)
 
 	cu, err := client.User.Current(c)
 	if err != nil {
@@ -850,7 +959,7 @@ func (client *Client) dsGet(c *gin.Context, g *Game) error {
 		restful.AddErrorf(c, err.Error())
 		return err
 	case g == nil:
-		err = fmt.Errorf("Unable to get game for id: %v", g.ID)
+		err = fmt.Errorf("Unable to get game for id: %v", g.ID())
 		restful.AddErrorf(c, err.Error())
 		return err
 	}
@@ -868,6 +977,19 @@ func (client *Client) dsGet(c *gin.Context, g *Game) error {
 		restful.AddErrorf(c, err.Error())
 		return err
 	}
+
+	// Decks shuffled by the migration are saved at once, so that later loads do not shuffle them anew.
+	if g.migrateDecks() {
+		err = client.saveMigration(c, g)
+		if err == errMigrationRaced {
+			return client.dsGet(c, g)
+		}
+		if err != nil {
+			restful.AddErrorf(c, err.Error())
+			return err
+		}
+	}
+
 	cu, err := client.User.Current(c)
 	if err != nil {
 		client.Log.Debugf(err.Error())
@@ -878,6 +1000,33 @@ func (client *Client) dsGet(c *gin.Context, g *Game) error {
 	return nil
 }
 
+var errMigrationRaced = errors.New("game saved while being migrated")
+
+// saveMigration saves a game changed by a migration upon loading.  The game is saved only if
+// unchanged since loaded, as another load may have migrated and saved it first.
+func (client *Client) saveMigration(c *gin.Context, g *Game) error {
+	_, err := client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
+		oldG := New(c, g.ID())
+		err := tx.Get(oldG.Key, oldG.Header)
+		if err != nil {
+			return err
+		}
+
+		if oldG.UpdatedAt != g.UpdatedAt {
+			return errMigrationRaced
+		}
+
+		err = g.encode(c)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Put(g.Key, g.Header)
+		return err
+	})
+	return err
+}
+
 func JSON(c *gin.Context) {
 	c.JSON(http.StatusOK, gameFrom(c))
 }
@@ -891,13 +1040,61 @@ func (client *Client) jsonIndexAction(prefix string) gin.HandlerFunc {
 	}
 }
 
+// turnsJSON lists the running games awaiting the turn of the current user.
+func (client *Client) turnsJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	cu, err := client.User.Current(c)
+	if err != nil || cu == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"Error": localeFrom(c).Sprintf("error.must-be-logged-in")})
+		return
+	}
+
+	q := datastore.NewQuery(kind).
+		Ancestor(pk(c)).
+		Filter("Status=", int(game.Running)).
+		Filter("UserIDS=", cu.ID())
+
+	var hs []*game.Header
+	_, err = client.DS.GetAll(c, q, &hs)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	type turn struct {
+		ID        int64
+		Title     string
+		Round     int
+		Phase     string
+		UpdatedAt time.Time
+	}
+
+	l := localeFrom(c)
+	ts := []*turn{}
+	for _, h := range hs {
+		if h.IsCurrentPlayer(cu) {
+			ts = append(ts, &turn{ID: h.ID(), Title: h.Title, Round: h.Round, Phase: l.Name(PhaseNames[h.Phase]), UpdatedAt: h.UpdatedAt})
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"Games": ts})
+}
+
+// wantsJSON reports whether the request prefers a JSON response, as terminal clients do, to the
+// redirects and flash messages served to browsers.
+func wantsJSON(c *gin.Context) bool {
+	return c.NegotiateFormat(gin.MIMEHTML, gin.MIMEJSON) == gin.MIMEJSON
+}
+
 func (g *Game) updateHeader() {
 	g.OptString = g.options()
 	switch g.Phase {
 	case GameOver:
 		g.Progress = g.PhaseName()
 	default:
-		g.Progress = fmt.Sprintf("<div>Round: %d</div><div>Phase: %s</div>", g.Round, g.PhaseName())
+		g.Progress = g.Locale().Sprintf("header.progress", g.Round, g.PhaseName())
 	}
 	// if u := g.Creator; u != nil {
 	// 	g.CreatorSID = user.GenID(u.GoogleID)
@@ -2,7 +2,6 @@ package confucius
 
 import (
 	"encoding/gob"
-	"fmt"
 	"html/template"
 
 	"github.com/SlothNinja/log"
@@ -21,12 +20,13 @@ type GiftCount struct {
 
 type GiftCounts []*GiftCount
 
-func (g *Game) countGiftsPhase() {
+func (g *Game) countGiftsPhase() error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = CountGifts
-	g.beginningOfPhaseReset()
+	if err := g.setPhase(CountGifts); err != nil {
+		return err
+	}
 
 	counts := make(GiftCounts, g.NumPlayers)
 
@@ -49,6 +49,7 @@ func (g *Game) countGiftsPhase() {
 
 	// Create ActionLog Entry
 	g.newCountGiftsEntry(counts)
+	return nil
 }
 
 type countGiftsEntry struct {
@@ -67,10 +68,11 @@ func (g *Game) newCountGiftsEntry(counts GiftCounts) *countGiftsEntry {
 
 func (e *countGiftsEntry) HTML() template.HTML {
 	g := e.Game().(*Game)
+	l := e.locale()
 	var s string
 	for _, count := range e.Counts {
-		s += fmt.Sprintf("<div>%s received %d action cubes for giving %d gifts and receiving %d gifts.</div>",
-			g.NameByPID(count.PlayerID), count.ActionCubes, count.GiftsGiven, count.GiftsReceived)
+		s += "<div>" + l.Sprintf("entry.count-gifts",
+			g.NameByPID(count.PlayerID), count.ActionCubes, count.GiftsGiven, count.GiftsReceived) + "</div>"
 	}
 	return template.HTML(s)
 }
@@ -1,6 +1,8 @@
 package confucius
 
 import (
+	"strings"
+
 	"cloud.google.com/go/datastore"
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
@@ -17,6 +19,7 @@ func New(c *gin.Context, id int64) *Game {
 	g.State = newState()
 	g.Key.Parent = pk(c)
 	g.Type = gtype.Confucius
+	g.locale = localeFrom(c)
 	return g
 }
 
@@ -42,10 +45,16 @@ func (client *Client) init(c *gin.Context, g *Game) error {
 		return err
 	}
 
+	g.migrateVariants()
+
 	for _, player := range g.Players() {
 		player.init(g)
 	}
 
+	if g.Neutral != nil {
+		g.Neutral.init(g)
+	}
+
 	for _, entry := range g.Log {
 		entry.Init(g)
 	}
@@ -65,6 +74,12 @@ func (client *Client) init(c *gin.Context, g *Game) error {
 	for _, land := range g.DistantLands {
 		land.init(g)
 	}
+
+	g.migrateDecision()
+
+	if rs := g.Stalled(); !rs.None() {
+		client.Log.Warningf("game %d stalled: %s", g.ID(), strings.Join(rs, "; "))
+	}
 	return nil
 }
 
@@ -80,6 +95,12 @@ func (g *Game) fromForm(c *gin.Context, cu *user.User) error {
 		Title          string `form:"title"`
 		NumPlayers     int    `form:"num-players" binding"min=0,max=5"`
 		Password       string `form:"password"`
+		Variants       []int  `form:"variants"`
+		SoloTarget     int    `form:"solo-target" binding:"min=0"`
+		ForeignLands   []int  `form:"foreign-lands"`
+		DistantChits   []int  `form:"distant-land-chits"`
+		MinistryChits  []int  `form:"ministry-chits"`
+		BalancedSetup  bool   `form:"balanced-setup"`
 		BasicGame      bool   `form:"basic-game"`
 		AdmiralVariant bool   `form:"admiral-variant"`
 	}{}
@@ -109,8 +130,18 @@ func (g *Game) fromForm(c *gin.Context, cu *user.User) error {
 		g.NumPlayers = obj.NumPlayers
 	}
 
-	g.BasicGame = obj.BasicGame
-	g.AdmiralVariant = obj.AdmiralVariant
+	g.setVariants(variantsFromForm(obj.Variants, obj.BasicGame, obj.AdmiralVariant))
+	err = g.validateVariants()
+	if err != nil {
+		return err
+	}
+	g.SoloTarget = obj.SoloTarget
+
+	g.Setup = setupFromForm(obj.ForeignLands, obj.DistantChits, obj.MinistryChits, obj.BalancedSetup)
+	err = g.validateSetup()
+	if err != nil {
+		return err
+	}
 	g.Password = obj.Password
 
 	g.AddCreator(cu)
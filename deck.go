@@ -0,0 +1,142 @@
+package confucius
+
+import (
+	"encoding/gob"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gob.RegisterName("*game.reshuffleEntry", new(reshuffleEntry))
+}
+
+// reshuffleConDeck shuffles the Confucius discard pile to form a new deck.  Cards revealed before
+// being discarded are hidden once more.
+func (g *Game) reshuffleConDeck() {
+	g.ConDeck, g.ConDiscardPile = g.ConDiscardPile, ConCards{}
+	for _, card := range g.ConDeck {
+		card.Revealed = false
+	}
+	g.ConDeck.Shuffle()
+	g.newReshuffleEntry(len(g.ConDeck))
+}
+
+// migrateDecks shuffles the decks of games saved before draws were made from the top of the deck,
+// returning true if the decks were shuffled.
+func (g *Game) migrateDecks() bool {
+	if g.ShuffledDecks {
+		return false
+	}
+	g.ConDeck.Shuffle()
+	g.EmperorDeck.Shuffle()
+	g.OfficialsDeck.Shuffle()
+	g.ShuffledDecks = true
+	return true
+}
+
+type reshuffleEntry struct {
+	*Entry
+	Cards int
+}
+
+func (g *Game) newReshuffleEntry(cards int) *reshuffleEntry {
+	e := &reshuffleEntry{
+		Entry: g.newEntry(),
+		Cards: cards,
+	}
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *reshuffleEntry) HTML() template.HTML {
+	l := e.locale()
+	return l.HTML("entry.reshuffle", e.Cards, l.Plural("card", e.Cards))
+}
+
+// DeckInfo reports the public information about the decks: the cards left in each deck, the
+// Confucius cards seen, by coins, and the contents of the discard piles.  Confucius cards are seen
+// once discarded or shown to all players, until the discard pile is shuffled to form a new deck.
+// Cards held by players are unseen, even by their holders, as they are not public.
+type DeckInfo struct {
+	ConCardsLeft     int
+	ConCardsSeen     map[int]int
+	ConCardsUnseen   map[int]int
+	ConDiscardPile   map[int]int
+	EmperorCardsLeft int
+	EmperorDiscard   []string
+	OfficialsLeft    int
+}
+
+// coinValues lists the coins of the Confucius cards.
+var coinValues = []int{1, 2, 3}
+
+// DeckInfo returns the public information about the decks.
+func (g *Game) DeckInfo() *DeckInfo {
+	info := &DeckInfo{
+		ConCardsLeft:     len(g.ConDeck),
+		ConCardsSeen:     make(map[int]int),
+		ConCardsUnseen:   make(map[int]int),
+		ConDiscardPile:   make(map[int]int),
+		EmperorCardsLeft: len(g.EmperorDeck),
+		OfficialsLeft:    len(g.OfficialsDeck),
+	}
+
+	all := append(ConCards{}, g.ConDeck...)
+	all = append(all, g.ConDiscardPile...)
+	for _, p := range g.Players() {
+		all = append(all, p.ConCardHand...)
+	}
+	for _, c := range g.Candidates {
+		all = append(all, c.PlayerCards...)
+		all = append(all, c.OtherPlayerCards...)
+	}
+	shown := g.shownConCards()
+
+	for _, coins := range coinValues {
+		discarded := g.ConDiscardPile.Count(coins)
+		seen := discarded + shown.Count(coins)
+		info.ConDiscardPile[coins] = discarded
+		info.ConCardsSeen[coins] = seen
+		info.ConCardsUnseen[coins] = all.Count(coins) - seen
+	}
+
+	l := g.Locale()
+	for _, card := range g.EmperorDiscard {
+		info.EmperorDiscard = append(info.EmperorDiscard, l.Name(card.Title()))
+	}
+	return info
+}
+
+// shownConCards returns the Confucius cards shown to all players but not yet discarded: the cards
+// drawn face up from the deck to tutor the neutral student.  Revealed is not consulted, as it
+// records only that the holder of a card has seen it.
+func (g *Game) shownConCards() ConCards {
+	var cards ConCards
+	if g.Neutral == nil {
+		return cards
+	}
+	for _, c := range g.Candidates {
+		switch {
+		case g.Neutral.Equal(c.Player()):
+			cards = append(cards, c.PlayerCards...)
+		case g.Neutral.Equal(c.OtherPlayer()):
+			cards = append(cards, c.OtherPlayerCards...)
+		}
+	}
+	return cards
+}
+
+func (client *Client) decksJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, g.DeckInfo())
+}
@@ -0,0 +1,22 @@
+package confucius
+
+import "testing"
+
+func TestDeckInfoSeen(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	p := g.Players()[0]
+	p.ConCardHand = ConCards{{Coins: 2, Revealed: true}, {Coins: 2}}
+	g.ConDiscardPile = ConCards{{Coins: 2}, {Coins: 3}}
+
+	info := g.DeckInfo()
+	// Cards held by a player are unseen, though their holder has seen them.
+	if got, want := info.ConCardsSeen[2], 1; got != want {
+		t.Errorf("2-coin cards seen: got %d, want %d", got, want)
+	}
+	if got, want := info.ConCardsSeen[3], 1; got != want {
+		t.Errorf("3-coin cards seen: got %d, want %d", got, want)
+	}
+	if got, want := info.ConDiscardPile[2], 1; got != want {
+		t.Errorf("2-coin cards discarded: got %d, want %d", got, want)
+	}
+}
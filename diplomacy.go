@@ -0,0 +1,628 @@
+package confucius
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/codec"
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/mlog"
+	"github.com/SlothNinja/sn"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+const diplomacyKind = "Diplomacy"
+
+// Diplomacy holds the private channels between the players of a game.  Unlike the public message
+// log, a channel may only be read by its members until the game is completed.
+type Diplomacy struct {
+	Key        *datastore.Key `datastore:"__key__"`
+	Channels   []*Channel     `datastore:"-"`
+	SavedState []byte         `datastore:",noindex"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Channel is a private conversation between a subset of the players of a game.  ReadAt provides
+// the time at which each member last read the channel.  Hidden and Deleted provide the indices of the
+// messages hidden by admins and deleted by their authors, as the moderation of the message log does.
+type Channel struct {
+	ID       int
+	UserIDS  []int64
+	Messages []*mlog.Message
+	ReadAt   map[int64]time.Time
+	Hidden   []int
+	Deleted  []int
+}
+
+func newDiplomacyKey(c *gin.Context, id int64) *datastore.Key {
+	return datastore.IDKey(diplomacyKind, id, pk(c))
+}
+
+func newDiplomacy(c *gin.Context, id int64) *Diplomacy {
+	return &Diplomacy{Key: newDiplomacyKey(c, id)}
+}
+
+func (d *Diplomacy) Load(ps []datastore.Property) error {
+	err := datastore.LoadStruct(d, ps)
+	if err != nil {
+		return err
+	}
+
+	var cs []*Channel
+	err = codec.Decode(&cs, d.SavedState)
+	if err != nil {
+		return err
+	}
+	d.Channels = cs
+	return nil
+}
+
+func (d *Diplomacy) Save() ([]datastore.Property, error) {
+	v, err := codec.Encode(d.Channels)
+	if err != nil {
+		return nil, err
+	}
+	d.SavedState = v
+	return datastore.SaveStruct(d)
+}
+
+func (d *Diplomacy) LoadKey(k *datastore.Key) error {
+	d.Key = k
+	return nil
+}
+
+func (d *Diplomacy) channel(id int) *Channel {
+	for _, ch := range d.Channels {
+		if ch.ID == id {
+			return ch
+		}
+	}
+	return nil
+}
+
+// channelFor returns the channel having exactly the given members.
+func (d *Diplomacy) channelFor(uids []int64) *Channel {
+	for _, ch := range d.Channels {
+		if sameIDS(ch.UserIDS, uids) {
+			return ch
+		}
+	}
+	return nil
+}
+
+func sameIDS(ids1, ids2 []int64) bool {
+	if len(ids1) != len(ids2) {
+		return false
+	}
+	for i := range ids1 {
+		if ids1[i] != ids2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (ch *Channel) hasMember(uid int64) bool {
+	for _, id := range ch.UserIDS {
+		if id == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// Unread returns the number of messages of other members posted since the user last read the channel.
+func (ch *Channel) Unread(uid int64) int {
+	readAt := ch.ReadAt[uid]
+	count := 0
+	for _, m := range ch.Messages {
+		if m.CreatorID != uid && m.CreatedAt.After(readAt) {
+			count += 1
+		}
+	}
+	return count
+}
+
+func (ch *Channel) markRead(uid int64) {
+	if ch.ReadAt == nil {
+		ch.ReadAt = make(map[int64]time.Time)
+	}
+	ch.ReadAt[uid] = time.Now()
+}
+
+// canRead returns true if the user may read the channel: members may always read their channels,
+// admins may moderate any channel, and all channels become public once the game is completed.
+func (g *Game) canRead(ch *Channel, cu *user.User) bool {
+	switch {
+	case g.Status == game.Completed:
+		return true
+	case cu == nil:
+		return false
+	case cu.IsAdmin():
+		return true
+	default:
+		return ch.hasMember(cu.ID())
+	}
+}
+
+// channelSummary provides a channel and its unread indicator for the current user.
+type channelSummary struct {
+	*Channel
+	Names  []string
+	Unread int
+}
+
+// summarize returns the summary of the channel for the user.  Hidden messages are replaced, unless
+// the user is an admin.
+func (g *Game) summarize(ch *Channel, cu *user.User) *channelSummary {
+	s := &channelSummary{Channel: ch}
+	if !cu.IsAdmin() && len(ch.Hidden) != 0 {
+		cp := *ch
+		cp.Messages = hideMessages(ch.Messages, ch.Hidden)
+		s.Channel = &cp
+	}
+	for _, uid := range ch.UserIDS {
+		if p := g.playerByUserID(uid); p != nil {
+			s.Names = append(s.Names, g.NameFor(p))
+		}
+	}
+	if cu != nil && ch.hasMember(cu.ID()) {
+		s.Unread = ch.Unread(cu.ID())
+	}
+	return s
+}
+
+func (g *Game) playerByUserID(uid int64) *Player {
+	if p := g.PlayererByUserID(uid); p != nil {
+		return p.(*Player)
+	}
+	return nil
+}
+
+func (client *Client) getDiplomacy(c *gin.Context, id int64) (*Diplomacy, error) {
+	d := newDiplomacy(c, id)
+	err := client.DS.Get(c, d.Key, d)
+	if err == datastore.ErrNoSuchEntity {
+		return d, nil
+	}
+	return d, err
+}
+
+// diplomacyJSON lists the channels the current user may read.
+func (client *Client) diplomacyJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	d, err := client.getDiplomacy(c, g.ID())
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ss := []*channelSummary{}
+	for _, ch := range d.Channels {
+		if g.canRead(ch, cu) {
+			ss = append(ss, g.summarize(ch, cu))
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"Channels": ss})
+}
+
+func getChannelID(c *gin.Context) (int, error) {
+	id, err := strconv.Atoi(c.Param("cid"))
+	if err != nil {
+		return -1, ErrInvalidID
+	}
+	return id, nil
+}
+
+// updateDiplomacy applies f to the diplomacy of the game within a transaction.
+func (client *Client) updateDiplomacy(c *gin.Context, id int64, f func(*Diplomacy) error) error {
+	_, err := client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
+		d := newDiplomacy(c, id)
+		err := tx.Get(d.Key, d)
+		switch {
+		case err == datastore.ErrNoSuchEntity:
+			d.CreatedAt = time.Now()
+		case err != nil:
+			return err
+		}
+
+		err = f(d)
+		if err != nil {
+			return err
+		}
+
+		d.UpdatedAt = time.Now()
+		_, err = tx.Put(d.Key, d)
+		return err
+	})
+	return err
+}
+
+// createChannel opens a channel between the current user and the selected players of the game.
+// The existing channel is returned, if the players already share one.
+func (client *Client) createChannel(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil || cu == nil || !g.HasUser(cu) {
+		c.JSON(http.StatusForbidden, gin.H{"Error": localeFrom(c).Sprintf("error.only-players-may-open-channel")})
+		return
+	}
+
+	uids := []int64{cu.ID()}
+	for _, sid := range c.PostFormArray("user-ids") {
+		uid, err := strconv.ParseInt(sid, 10, 64)
+		if err != nil || g.playerByUserID(uid) == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"Error": localeFrom(c).VError("error.received-invalid-player").Error()})
+			return
+		}
+		if uid != cu.ID() {
+			uids = append(uids, uid)
+		}
+	}
+
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	for i := len(uids) - 1; i > 0; i-- {
+		if uids[i] == uids[i-1] {
+			uids = append(uids[:i], uids[i+1:]...)
+		}
+	}
+
+	if len(uids) < 2 {
+		c.JSON(http.StatusBadRequest, gin.H{"Error": localeFrom(c).VError("error.channel-requires-at-least-two-players").Error()})
+		return
+	}
+
+	var ch *Channel
+	err = client.updateDiplomacy(c, g.ID(), func(d *Diplomacy) error {
+		if ch = d.channelFor(uids); ch != nil {
+			return nil
+		}
+		ch = &Channel{ID: len(d.Channels) + 1, UserIDS: uids}
+		d.Channels = append(d.Channels, ch)
+		return nil
+	})
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, g.summarize(ch, cu))
+}
+
+// channelJSON returns the history of a channel, marking it read by the current user.
+func (client *Client) channelJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cid, err := getChannelID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	var ch *Channel
+	err = client.updateDiplomacy(c, g.ID(), func(d *Diplomacy) error {
+		ch = d.channel(cid)
+		if ch == nil || !g.canRead(ch, cu) {
+			return ErrInvalidID
+		}
+		if cu != nil && ch.hasMember(cu.ID()) {
+			ch.markRead(cu.ID())
+		}
+		return nil
+	})
+	if err == ErrInvalidID {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, g.summarize(ch, cu))
+}
+
+// addChannelMessage posts a message to a channel of which the current user is a member.
+func (client *Client) addChannelMessage(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cid, err := getChannelID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil || cu == nil {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	if client.muted(c, g, cu) {
+		c.JSON(http.StatusForbidden, gin.H{"Error": localeFrom(c).Sprintf("error.been-muted-game")})
+		return
+	}
+
+	text := c.PostForm("message")
+	var m *mlog.Message
+	err = client.updateDiplomacy(c, g.ID(), func(d *Diplomacy) error {
+		ch := d.channel(cid)
+		if ch == nil || !ch.hasMember(cu.ID()) {
+			return ErrInvalidID
+		}
+		err := validateNewMessage(localeFrom(c), ch.Messages, cu, text)
+		if err != nil {
+			return err
+		}
+		m = mlog.NewMessage(cu, text)
+		ch.Messages = append(ch.Messages, m)
+		ch.markRead(cu.ID())
+		return nil
+	})
+	if err == ErrInvalidID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	if sn.IsVError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"Error": err.Error()})
+		return
+	}
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
+
+// editChannelMessage replaces the text of a channel message posted by the current user within the
+// edit window.
+func (client *Client) editChannelMessage(c *gin.Context) {
+	client.changeChannelMessage(c, func(l Locale, ch *Channel, i int, m *mlog.Message) error {
+		text := c.PostForm("message")
+		err := validateMessageText(l, text)
+		if err != nil {
+			return err
+		}
+		m.Text = text
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+}
+
+// deleteChannelMessage removes the text of a channel message posted by the current user within the
+// edit window.
+func (client *Client) deleteChannelMessage(c *gin.Context) {
+	client.changeChannelMessage(c, func(l Locale, ch *Channel, i int, m *mlog.Message) error {
+		m.Text = deletedMessageText
+		m.UpdatedAt = time.Now()
+		ch.Deleted = append(ch.Deleted, i)
+		return nil
+	})
+}
+
+// changeChannelMessage applies f to the channel message identified by the request, provided the
+// current user may change it, and responds with the changed message.
+func (client *Client) changeChannelMessage(c *gin.Context, f func(Locale, *Channel, int, *mlog.Message) error) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cid, err := getChannelID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	i, err := getMessageIndex(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil || cu == nil {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	l := localeFrom(c)
+	var m *mlog.Message
+	err = client.updateDiplomacy(c, g.ID(), func(d *Diplomacy) error {
+		ch := d.channel(cid)
+		if ch == nil || !ch.hasMember(cu.ID()) {
+			return ErrInvalidID
+		}
+		var err error
+		m, err = validateAuthorChange(l, ch.Messages, i, cu, ch.Deleted, ch.Hidden)
+		if err != nil {
+			return err
+		}
+		return f(l, ch, i, m)
+	})
+	if err == ErrInvalidID {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	if sn.IsVError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"Error": err.Error()})
+		return
+	}
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
+
+// hideChannelMessage hides or reveals a channel message, provided the current user is an admin.
+func (client *Client) hideChannelMessage(hidden bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client.Log.Debugf(msgEnter)
+		defer client.Log.Debugf(msgExit)
+
+		cu, err := client.User.Current(c)
+		if err != nil || !cu.IsAdmin() {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		id, err := getID(c)
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		cid, err := getChannelID(c)
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		i, err := getMessageIndex(c)
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		var ch *Channel
+		err = client.updateDiplomacy(c, id, func(d *Diplomacy) error {
+			ch = d.channel(cid)
+			switch {
+			case ch == nil:
+				return ErrInvalidID
+			case i < 0 || i >= len(ch.Messages):
+				return localeFrom(c).VError("error.received-invalid-message")
+			}
+			ch.Hidden = removeID(ch.Hidden, i)
+			if hidden {
+				ch.Hidden = append(ch.Hidden, i)
+			}
+			return nil
+		})
+		if err == ErrInvalidID {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if sn.IsVError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"Error": err.Error()})
+			return
+		}
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ch)
+	}
+}
+
+// moderateDiplomacy provides admins all channels of the game, including their messages.
+func (client *Client) moderateDiplomacy(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil || !cu.IsAdmin() {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	d, err := client.getDiplomacy(c, g.ID())
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ss := make([]*channelSummary, len(d.Channels))
+	for i, ch := range d.Channels {
+		ss[i] = g.summarize(ch, cu)
+	}
+	c.JSON(http.StatusOK, gin.H{"Channels": ss})
+}
+
+// unreadDiplomacy returns the number of unread private messages of the user, for the unread
+// indicator of the game page.
+func (client *Client) unreadDiplomacy(c *gin.Context, g *Game, cu *user.User) int {
+	if g == nil || cu == nil {
+		return 0
+	}
+
+	d, err := client.getDiplomacy(c, g.ID())
+	if err != nil {
+		client.Log.Warningf(err.Error())
+		return 0
+	}
+
+	count := 0
+	for _, ch := range d.Channels {
+		if ch.hasMember(cu.ID()) {
+			count += ch.Unread(cu.ID())
+		}
+	}
+	return count
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -17,12 +16,13 @@ func init() {
 	gob.RegisterName("*game.discardEntry", new(discardEntry))
 }
 
-func (g *Game) discardPhase(c *gin.Context) bool {
+func (g *Game) discardPhase(c *gin.Context) (bool, error) {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = Discard
-	g.beginningOfPhaseReset()
+	if err := g.setPhase(Discard); err != nil {
+		return false, err
+	}
 
 	ps := make(game.Playerers, 0)
 	for _, p := range g.Players() {
@@ -34,7 +34,7 @@ func (g *Game) discardPhase(c *gin.Context) bool {
 	}
 
 	g.SetCurrentPlayerers(ps...)
-	return len(ps) == 0
+	return len(ps) == 0, nil
 }
 
 func (g *Game) discard(c *gin.Context, cu *user.User) (string, game.ActionType, error) {
@@ -78,7 +78,7 @@ func (p *Player) newDiscardEntry(cards ...*ConCard) *discardEntry {
 }
 
 func (e *discardEntry) HTML() template.HTML {
-	return restful.HTML("%s discarded %d cards.", e.Player().Name(), len(e.Discarded))
+	return e.locale().HTML("entry.discard", e.Player().Name(), len(e.Discarded))
 }
 
 func (g *Game) validateDiscard(c *gin.Context, cu *user.User) (ConCards, error) {
@@ -94,20 +94,28 @@ func (g *Game) validateDiscard(c *gin.Context, cu *user.User) (ConCards, error)
 	newHandCount := len(cp.ConCardHand) - len(cards)
 	switch {
 	case !g.IsCurrentPlayer(cu):
-		return nil, sn.NewVError("Only a current player may discard cards.")
+		return nil, g.vError("error.only-current-player-may-discard-cards")
 	case g.Phase != Discard:
-		return nil, sn.NewVError("You cannot discard cards during the %s phase.", g.PhaseName())
+		return nil, g.vError("error.cannot-discard-cards-during-phase", g.PhaseName())
 	case newHandCount != 4:
-		return nil, sn.NewVError("You must discard down to 4 cards.  You have discarded to %d cards.",
-			newHandCount)
+		return nil, g.vError("error.must-discard-down-4-cards-discarded", newHandCount)
 	default:
 		return cards, nil
 	}
 }
 
 func (g *Game) EnableDiscard(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.Phase == Discard && g.CurrentPlayer() != nil &&
-		!g.CurrentPlayer().PerformedAction
+	return g.DiscardReasons(cu).None()
+}
+
+// DiscardReasons returns why the user cu may not discard Confucius cards.
+func (g *Game) DiscardReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.phase(Discard).notPerformed(cp)
+	return r.reasons
 }
 
 func (client *Client) discardPhaseFinishTurn(c *gin.Context, g *Game, cu *user.User) (*user.Stats, []*contest.Contest, error) {
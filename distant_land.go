@@ -90,13 +90,13 @@ func (g *Game) hasDistantLandFor(p *Player) bool {
 var distanLandIDS = []DistantLandID{SpiceIslands, India, Arabia, Africa, Americas}
 
 func (g *Game) CreateDistantLands() {
-	distantLandChits := DistantLandChits{2, 2, 3, 3, 4, 4, 4}
+	distantLandChits := g.drawDistantLandChits()
 	g.DistantLands = make(DistantLands, len(distanLandIDS))
 
-	for _, key := range distanLandIDS {
+	for i, key := range distanLandIDS {
 		g.DistantLands[key] = new(DistantLand)
 		g.DistantLands[key].ID = key
-		g.DistantLands[key].Chit = distantLandChits.Draw()
+		g.DistantLands[key].Chit = distantLandChits[i]
 	}
 }
 
@@ -44,6 +44,7 @@ func NewEmperorDeck() EmperorCards {
 	for i, t := range emperorCardTypes {
 		deck[i] = NewEmperorCard(t)
 	}
+	deck.Shuffle()
 	return deck
 }
 
@@ -75,11 +76,17 @@ func (cds *EmperorCards) Draw() *EmperorCard {
 	return c
 }
 
+// DrawS draws the top card of the deck, returning nil if the deck is empty.
 func (cds EmperorCards) DrawS() (EmperorCards, *EmperorCard) {
-	i := sn.MyRand.Intn(len(cds))
-	c := cds[i]
-	deck := append(cds[:i], cds[i+1:]...)
-	return deck, c
+	if len(cds) == 0 {
+		return cds, nil
+	}
+	return cds[1:], cds[0]
+}
+
+// Shuffle shuffles the cards in place.
+func (cds EmperorCards) Shuffle() {
+	sn.MyRand.Shuffle(len(cds), func(i, j int) { cds[i], cds[j] = cds[j], cds[i] })
 }
 
 func (cds EmperorCards) Reveal() {
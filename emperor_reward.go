@@ -8,7 +8,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -23,19 +22,29 @@ func init() {
 }
 
 func (g *Game) EnableEmperorReward(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.CurrentPlayer().canEmperorReward()
+	return g.EmperorRewardReasons(cu).None()
 }
 
-func (p *Player) canEmperorReward() bool {
-	switch {
-	case p.Game().Phase != Actions:
-		return false
-	case p.Game().ExtraAction:
-		return false
-	case len(p.EmperorHand) < 1:
-		return false
+// EmperorRewardReasons returns why the user cu may not play an Emperor's Reward card.
+func (g *Game) EmperorRewardReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
 	}
-	return true
+	return cp.emperorRewardReasons()
+}
+
+// emperorRewardReasons returns why the player may not play an Emperor's Reward card, whoever is asking.
+func (p *Player) emperorRewardReasons() Reasons {
+	r := &reasonCheck{g: p.Game()}
+	r.phase(Actions).
+		require(!p.Game().ExtraAction, "reason.extra-action").
+		require(len(p.EmperorHand) >= 1, "reason.no-emperor-cards")
+	return r.reasons
+}
+
+func (p *Player) canEmperorReward() bool {
+	return p.emperorRewardReasons().None()
 }
 
 func (g *Game) takeCash(c *gin.Context, cu *user.User) (string, game.ActionType, error) {
@@ -61,7 +70,7 @@ func (g *Game) takeCash(c *gin.Context, cu *user.User) (string, game.ActionType,
 
 	// Set flash message
 	restful.AddNoticef(c, string(e.HTML()))
-	return "", game.Cache, nil
+	return "", g.commit(cp), nil
 }
 
 type takeCashEntry struct {
@@ -77,7 +86,7 @@ func (g *Game) NewTakeCashEntry(p *Player) *takeCashEntry {
 }
 
 func (e *takeCashEntry) HTML() template.HTML {
-	return restful.HTML("%s played Emperor's Reward card to take four Confucius cards.", e.Player().Name())
+	return e.locale().HTML("entry.take-cash", e.Player().Name())
 }
 
 func (g *Game) validateTakeCash(c *gin.Context, cu *user.User) (*EmperorCard, error) {
@@ -95,7 +104,7 @@ func (g *Game) validateTakeCash(c *gin.Context, cu *user.User) (*EmperorCard, er
 	}
 
 	if !cd.hasType(Cash) {
-		return nil, sn.NewVError("You did not play the correct emperor's reward card for the selected action.")
+		return nil, g.vError("error.did-not-play-correct-emperors-reward")
 	}
 	return cd, nil
 }
@@ -146,8 +155,9 @@ func (g *Game) NewTakeGiftEntry(p *Player) *takeGiftEntry {
 }
 
 func (e *takeGiftEntry) HTML() template.HTML {
-	return restful.HTML("%s used Emperor's Reward card to take %d value gift (%s).",
-		e.Player().Name(), e.Gift.Value, e.Gift.Name())
+	l := e.locale()
+	return l.HTML("entry.take-gift",
+		e.Player().Name(), e.Gift.Value, l.Name(e.Gift.Name()))
 }
 
 func (g *Game) validateTakeGift(c *gin.Context, cu *user.User) (*EmperorCard, *GiftCard, error) {
@@ -165,7 +175,7 @@ func (g *Game) validateTakeGift(c *gin.Context, cu *user.User) (*EmperorCard, *G
 	}
 
 	if !cd.hasType(FreeGift) {
-		return nil, nil, sn.NewVError("You did not play the correct emperor's reward card for the selected action.")
+		return nil, nil, g.vError("error.did-not-play-correct-emperors-reward")
 	}
 
 	gv, err := g.getGiftValue(c, "take-gift")
@@ -175,7 +185,7 @@ func (g *Game) validateTakeGift(c *gin.Context, cu *user.User) (*EmperorCard, *G
 
 	gc := g.CurrentPlayer().GetGift(gv)
 	if gc == nil {
-		return nil, nil, sn.NewVError("Selected gift card is not available.")
+		return nil, nil, g.vError("error.selected-gift-card-not-available")
 	}
 	return cd, gc, nil
 }
@@ -222,7 +232,7 @@ func (g *Game) NewTakeArmyEntry(p *Player) *takeArmyEntry {
 }
 
 func (e *takeArmyEntry) HTML() template.HTML {
-	return restful.HTML("%s played Emperor's Reward card to recruit an army.", e.Player().Name())
+	return e.locale().HTML("entry.take-army", e.Player().Name())
 }
 
 func (g *Game) validateTakeArmy(c *gin.Context, cu *user.User) (*EmperorCard, error) {
@@ -240,12 +250,12 @@ func (g *Game) validateTakeArmy(c *gin.Context, cu *user.User) (*EmperorCard, er
 	}
 
 	if !cd.hasType(RecruitFreeArmy) {
-		return nil, sn.NewVError("You did not play the correct emperor's reward card for the selected action.")
+		return nil, g.vError("error.did-not-play-correct-emperors-reward")
 	}
 
 	cp := g.CurrentPlayer()
 	if !cp.hasArmies() {
-		return nil, sn.NewVError("You have no armies to recruit.")
+		return nil, g.vError("error.no-armies-recruit")
 	}
 	return cd, nil
 }
@@ -290,7 +300,7 @@ func (g *Game) NewTakeExtraActionEntry(p *Player) *takeExtraActionEntry {
 }
 
 func (e *takeExtraActionEntry) HTML() template.HTML {
-	return restful.HTML("%s played Emperor's Reward card to perform action without paying an action cube.", e.Player().Name())
+	return e.locale().HTML("entry.take-extra-action", e.Player().Name())
 }
 
 func (g *Game) validateTakeExtraAction(c *gin.Context, cu *user.User) (*EmperorCard, error) {
@@ -308,7 +318,7 @@ func (g *Game) validateTakeExtraAction(c *gin.Context, cu *user.User) (*EmperorC
 	}
 
 	if !ec.hasType(ExtraAction) {
-		return nil, sn.NewVError("You did not play the correct emperor's reward card for the selected action.")
+		return nil, g.vError("error.did-not-play-correct-emperors-reward")
 	}
 	return ec, nil
 }
@@ -325,7 +335,7 @@ func (g *Game) avengeEmperor(c *gin.Context, cu *user.User) (string, game.Action
 	// Commit Recruited Army and Score Points
 	cp := g.CurrentPlayer()
 	cp.RecruitedArmies -= 1
-	cp.Score += 2
+	cp.addScore(AvengerScore, 2)
 	g.SetAvenger(cp)
 	cp.PerformedAction = true
 
@@ -356,7 +366,7 @@ func (g *Game) NewAvengeEmperorEntry(p *Player) *avengeEmperorEntry {
 }
 
 func (e *avengeEmperorEntry) HTML() template.HTML {
-	return restful.HTML("%s used Emperor's Reward card and army to avenge emperor.", e.Player().Name())
+	return e.locale().HTML("entry.avenge-emperor", e.Player().Name())
 }
 
 func (g *Game) validateAvengeEmperor(c *gin.Context, cu *user.User) (*EmperorCard, error) {
@@ -374,11 +384,11 @@ func (g *Game) validateAvengeEmperor(c *gin.Context, cu *user.User) (*EmperorCar
 	}
 
 	if !eCard.hasType(EmperorInsulted) {
-		return nil, sn.NewVError("You did not play the correct emperor's reward card for the selected action.")
+		return nil, g.vError("error.did-not-play-correct-emperors-reward")
 	}
 
 	if !g.CurrentPlayer().hasRecruitedArmies() {
-		return nil, sn.NewVError("You have no recruited armies with which to avenge the Emperor.")
+		return nil, g.vError("error.no-recruited-armies-avenge-emperor")
 	}
 	return eCard, nil
 }
@@ -441,11 +451,20 @@ func (g *Game) NewTakeBriberyRewardEntry(p *Player) *takeBriberyRewardEntry {
 }
 
 func (e *takeBriberyRewardEntry) HTML() template.HTML {
+	l := e.locale()
 	if e.OtherPlayer() == nil {
-		return restful.HTML("%s used Emperor's Reward card to place unsecured marker on %s official having %d seniority.", e.Player().Name(), e.MinistryName, e.Seniority)
+		return l.HTML("entry.take-bribery-reward", e.Player().Name(), l.Name(e.MinistryName), e.Seniority)
 	}
 	length := len(e.Played)
-	return restful.HTML("%s used Emperor's Reward card and %d Confucius %s having %d coins to replace unsecured marker of %s on %s official having %d seniority.", e.Player().Name(), length, pluralize("card", length), e.Played.Coins(), e.OtherPlayer().Name(), e.MinistryName, e.Seniority)
+	return l.HTML("entry.take-bribery-reward-replace", e.Player().Name(), length, l.Plural("card", length), e.Played.Coins(), e.OtherPlayer().Name(), l.Name(e.MinistryName), e.Seniority)
+}
+
+func (e *takeBriberyRewardEntry) influenceChanges() map[int]int {
+	changes := map[int]int{e.PlayerID: 1}
+	if e.OtherPlayerID != NoPlayerID {
+		changes[e.OtherPlayerID]--
+	}
+	return changes
 }
 
 func (g *Game) validateBriberyReward(c *gin.Context, cu *user.User) (*EmperorCard, ConCards, *Ministry, *OfficialTile, error) {
@@ -481,16 +500,16 @@ func (g *Game) validateBriberyReward(c *gin.Context, cu *user.User) (*EmperorCar
 	}
 
 	if !validMininstry {
-		return nil, nil, nil, nil, sn.NewVError("You must select a valid ministry for the selected card.")
+		return nil, nil, nil, nil, g.vError("error.must-select-valid-ministry-selected-card")
 	}
 
 	switch {
 	case o.Secured:
-		return nil, nil, nil, nil, sn.NewVError("You must select an official that doesn't have a secured marker.")
+		return nil, nil, nil, nil, g.vError("error.must-select-official-doesnt-secured-marker")
 	case cp.Equal(o.Player()):
-		return nil, nil, nil, nil, sn.NewVError("You must select an official that doesn't have your marker.")
+		return nil, nil, nil, nil, g.vError("error.must-select-official-doesnt-marker")
 	case o.Bribed() && !cp.canAffordToBribe(o):
-		return nil, nil, nil, nil, sn.NewVError("You selected cards having %d total coins, but you need %d coins to bribe the selected official.", cards.Coins(), cp.CostFor(o))
+		return nil, nil, nil, nil, g.vError("error.insufficient-coins-bribe", cards.Coins(), cp.CostFor(o))
 	}
 	return card, cards, ministry, o, nil
 }
@@ -19,22 +19,25 @@ func (client *Client) endOfGamePhase(c *gin.Context, g *Game) ([]*contest.Contes
 
 	if !g.endGame() {
 		g.newRoundPhase()
-		g.countGiftsPhase()
-		g.chooseChiefMinisterPhase()
-		return nil, nil
+		g.variantNewRound()
+		if err := g.countGiftsPhase(); err != nil {
+			return nil, err
+		}
+		return nil, g.chooseChiefMinisterPhase()
 	}
 
 	if !g.Ministries.allResolved() {
-		completed := g.ministryResolutionPhase(c, true)
-		if !completed {
-			return nil, nil
+		completed, err := g.ministryResolutionPhase(c, true)
+		if err != nil || !completed {
+			return nil, err
 		}
 	}
 	return client.endGameScoring(c, g)
 }
 
 func (g *Game) endGame() bool {
-	return g.Ministries.allResolved() || len(g.Candidates) <= 0 || g.Wall >= 9
+	end := g.Ministries.allResolved() || len(g.Candidates) <= 0 || g.Wall >= 9
+	return g.variantEndGame(end)
 }
 
 func (ms Ministries) allResolved() bool {
@@ -47,15 +50,28 @@ func (ms Ministries) allResolved() bool {
 }
 
 func (client *Client) endGameScoring(c *gin.Context, g *Game) ([]*contest.Contest, error) {
-	g.Phase = EndGameScoring
-	g.ScoreChiefMinister()
-	g.ScoreAdmiral()
-	g.ScoreGeneral()
+	if err := g.setPhase(EndGameScoring); err != nil {
+		return nil, err
+	}
+	if err := g.ScoreChiefMinister(); err != nil {
+		return nil, err
+	}
+	if err := g.ScoreAdmiral(); err != nil {
+		return nil, err
+	}
+	if err := g.ScoreGeneral(); err != nil {
+		return nil, err
+	}
+	if g.HasVariant(SoloVariant) {
+		return g.endSoloGame()
+	}
 	places, err := client.determinePlaces(c, g)
 	if err != nil {
 		return nil, err
 	}
-	g.SetWinners(places[0])
+	if err := g.SetWinners(places[0]); err != nil {
+		return nil, err
+	}
 	return contest.GenContests(c, places), nil
 }
 
@@ -70,15 +86,16 @@ func toIDS(places []Players) [][]int64 {
 }
 
 func (g *Game) SendEndGameNotifications(c *gin.Context) error {
-	g.Phase = GameOver
-	g.Status = game.Completed
+	if err := g.setPhase(GameOver); err != nil {
+		return err
+	}
 
 	ms := make([]mailjet.InfoMessagesV31, len(g.Players()))
-	subject := fmt.Sprintf("SlothNinja Games: Confucius #%d Has Ended", g.ID)
+	subject := fmt.Sprintf("SlothNinja Games: Confucius #%d Has Ended", g.ID())
 
 	var body string
 	for _, p := range g.Players() {
-		body += fmt.Sprintf("%s scored %d points.\n", g.NameFor(p), p.Score)
+		body += fmt.Sprintf("%s scored %d points (%s).\n", g.NameFor(p), p.Score, p.ScoreBreakdownString())
 	}
 
 	var names []string
@@ -89,6 +106,7 @@ func (g *Game) SendEndGameNotifications(c *gin.Context) error {
 
 	for i, p := range g.Players() {
 		u := p.User()
+		text := body + "\n\n" + g.RenderText(u)
 		ms[i] = mailjet.InfoMessagesV31{
 			From: &mailjet.RecipientV31{
 				Email: "webmaster@slothninja.com",
@@ -101,7 +119,7 @@ func (g *Game) SendEndGameNotifications(c *gin.Context) error {
 				},
 			},
 			Subject:  subject,
-			TextPart: body,
+			TextPart: text,
 		}
 	}
 	_, err := send.Messages(c, ms...)
@@ -126,184 +144,137 @@ func (pcs playerCounts) IncrementFor(player *Player, by ...int) {
 	pcs.SetFor(player, pcs.For(player)+increment)
 }
 
-func (g *Game) ScoreChiefMinister() {
-	g.Phase = AwardChiefMinister
+// awardedPoints returns the points logged for a title, defaulting to 1 for entries logged before
+// title points were recorded.
+func awardedPoints(points int) int {
+	if points == 0 {
+		return 1
+	}
+	return points
+}
 
-	counts := make(playerCounts)
-	for _, ministry := range g.Ministries {
-		for _, official := range ministry.Officials {
-			if official.Bribed() {
-				counts.IncrementFor(official.Player())
-			}
-		}
-		if minister := ministry.Minister(); minister != nil {
-			counts[minister.ID()] += 1
-		}
-		if secretary := ministry.Secretary(); secretary != nil {
-			counts[secretary.ID()] += 1
-		}
+// titleHolder returns the minister, who receives a title when its holders are tied.
+// The neutral family of the two player rules cannot hold a title.
+func (m *Ministry) titleHolder() *Player {
+	if minister := m.Minister(); !minister.isNeutral() {
+		return minister
 	}
+	return nil
+}
 
-	players := Players{}
-	max := 0
-	for _, player := range g.Players() {
-		switch {
-		case counts.For(player) == max:
-			players = append(players, player)
-		case counts.For(player) > max:
-			max = counts.For(player)
-			players = Players{player}
-			g.SetChiefMinister(player)
-		}
+func (g *Game) ScoreChiefMinister() error {
+	if err := g.setPhase(AwardChiefMinister); err != nil {
+		return err
 	}
 
-	if len(players) > 1 {
-		g.SetChiefMinister(g.Ministries[Hubu].Minister())
+	if s := g.titleStanding(ChiefMinisterTitle); s.decided {
+		g.SetChiefMinister(s.winner)
 	}
 
 	if chief := g.ChiefMinister(); chief != nil {
-		chief.Score += 1
-		g.NewScoreChiefMinisterEntry(chief)
+		points := g.titlePoints(ChiefMinisterTitle)
+		chief.addScore(ChiefMinisterTitleScore, points)
+		g.NewScoreChiefMinisterEntry(chief, points)
 	}
+	return nil
 }
 
 type scoreChiefMinisterEntry struct {
 	*Entry
+	Points int
 }
 
-func (g *Game) NewScoreChiefMinisterEntry(p *Player) *scoreChiefMinisterEntry {
+func (g *Game) NewScoreChiefMinisterEntry(p *Player, points int) *scoreChiefMinisterEntry {
 	e := new(scoreChiefMinisterEntry)
 	e.Entry = p.newEntry()
+	e.Points = points
 	p.Log = append(p.Log, e)
 	g.Log = append(g.Log, e)
 	return e
 }
 
 func (e *scoreChiefMinisterEntry) HTML() template.HTML {
-	return restful.HTML("%s awarded title of Chief Minister and 1 point.", e.Player().Name())
+	l := e.locale()
+	return l.HTML("entry.score-chief-minister", e.Player().Name(), awardedPoints(e.Points), l.Plural("point", awardedPoints(e.Points)))
 }
 
-func (g *Game) ScoreAdmiral() {
-	g.Phase = AwardAdmiral
-	counts := make(playerCounts)
-
-	// 5 for each junk at a distant land
-	for _, land := range g.DistantLands {
-		for _, player := range land.Players() {
-			counts.IncrementFor(player, 5)
-		}
+func (g *Game) ScoreAdmiral() error {
+	if err := g.setPhase(AwardAdmiral); err != nil {
+		return err
 	}
 
-	players := Players{}
-	max := 0
-	for _, player := range g.Players() {
-		// 1 for each junk at sea
-		counts[player.ID()] += player.OnVoyage
-
-		// Find max or those tied with max
-		switch {
-		case counts[player.ID()] == max:
-			players = append(players, player)
-		case counts[player.ID()] > max:
-			max = counts[player.ID()]
-			players = Players{player}
-			g.SetAdmiral(player)
-		}
-	}
-
-	// Only 1 then Admiral is already found. Otherwise Gongbu Minister is Admiral.
-	if len(players) > 1 {
-		g.SetAdmiral(g.Ministries[Gongbu].Minister())
+	if s := g.titleStanding(AdmiralTitle); s.decided {
+		g.SetAdmiral(s.winner)
 	}
 
 	if admiral := g.Admiral(); admiral != nil {
-		admiral.Score += 1
-		g.NewScoreAdmiralEntry(admiral)
+		points := g.titlePoints(AdmiralTitle)
+		admiral.addScore(AdmiralTitleScore, points)
+		g.NewScoreAdmiralEntry(admiral, points)
 	}
+	return nil
 }
 
 type scoreAdmiralEntry struct {
 	*Entry
+	Points int
 }
 
-func (g *Game) NewScoreAdmiralEntry(p *Player) *scoreAdmiralEntry {
+func (g *Game) NewScoreAdmiralEntry(p *Player, points int) *scoreAdmiralEntry {
 	e := new(scoreAdmiralEntry)
 	e.Entry = p.newEntry()
+	e.Points = points
 	p.Log = append(p.Log, e)
 	g.Log = append(g.Log, e)
 	return e
 }
 
 func (e *scoreAdmiralEntry) HTML() template.HTML {
-	return restful.HTML("%s awarded title of Admiral and 1 point.", e.Player().Name())
+	l := e.locale()
+	return l.HTML("entry.score-admiral", e.Player().Name(), awardedPoints(e.Points), l.Plural("point", awardedPoints(e.Points)))
 }
 
-func (g *Game) ScoreGeneral() {
-	g.Phase = AwardGeneral
-
-	counts := make(playerCounts)
-
-	// 1 for each army at a foreign land
-	for _, land := range g.ForeignLands {
-		for _, box := range land.Boxes {
-			if box.Player() != nil {
-				counts.IncrementFor(box.Player())
-			}
-		}
-	}
-
-	players := Players{}
-	max := 0
-	for _, player := range g.Players() {
-		// 1 for avenging emperor
-		if player.Equal(g.Avenger()) {
-			counts.IncrementFor(player)
-		}
-
-		// 1 for each army in military colonies
-		counts[player.ID()] += player.RecruitedArmies
-
-		// Find max or those tied with max
-		switch {
-		case counts[player.ID()] == max:
-			players = append(players, player)
-		case counts[player.ID()] > max:
-			max = counts[player.ID()]
-			players = Players{player}
-			g.SetGeneral(player)
-		}
+func (g *Game) ScoreGeneral() error {
+	if err := g.setPhase(AwardGeneral); err != nil {
+		return err
 	}
 
-	// Only 1 then General is already found. Otherwise Bingbu Minister is Admiral.
-	if len(players) > 1 {
-		g.SetGeneral(g.Ministries[Bingbu].Minister())
+	if s := g.titleStanding(GeneralTitle); s.decided {
+		g.SetGeneral(s.winner)
 	}
 
 	if general := g.General(); general != nil {
-		general.Score += 1
-		general.newScoreGeneralEntry()
+		points := g.titlePoints(GeneralTitle)
+		general.addScore(GeneralTitleScore, points)
+		general.newScoreGeneralEntry(points)
 	}
+	return nil
 }
 
 type scoreGeneralEntry struct {
 	*Entry
+	Points int
 }
 
-func (p *Player) newScoreGeneralEntry() *scoreGeneralEntry {
+func (p *Player) newScoreGeneralEntry(points int) *scoreGeneralEntry {
 	g := p.Game()
 	e := new(scoreGeneralEntry)
 	e.Entry = p.newEntry()
+	e.Points = points
 	p.Log = append(p.Log, e)
 	g.Log = append(g.Log, e)
 	return e
 }
 
 func (e *scoreGeneralEntry) HTML() template.HTML {
-	return restful.HTML("%s awarded title of General and 1 point.", e.Player().Name())
+	l := e.locale()
+	return l.HTML("entry.score-general", e.Player().Name(), awardedPoints(e.Points), l.Plural("point", awardedPoints(e.Points)))
 }
 
-func (g *Game) SetWinners(rmap contest.ResultsMap) {
-	g.Phase = AnnounceWinners
+func (g *Game) SetWinners(rmap contest.ResultsMap) error {
+	if err := g.setPhase(AnnounceWinners); err != nil {
+		return err
+	}
 	g.Status = game.Completed
 
 	g.SetCurrentPlayerers()
@@ -313,6 +284,7 @@ func (g *Game) SetWinners(rmap contest.ResultsMap) {
 	}
 
 	g.newAnnounceWinnersEntry()
+	return nil
 }
 
 //func (g *Game) SetWinners(winners Players) {
@@ -341,11 +313,12 @@ func (g *Game) newAnnounceWinnersEntry() *announceWinnersEntry {
 }
 
 func (e *announceWinnersEntry) HTML() template.HTML {
+	l := e.locale()
 	names := make([]string, len(e.Winners()))
 	for i, winner := range e.Winners() {
 		names[i] = winner.Name()
 	}
-	return restful.HTML("Congratulations to: %s.", restful.ToSentence(names))
+	return l.HTML("entry.announce-winners", l.ToSentence(names))
 }
 
 func (g *Game) Winners() Players {
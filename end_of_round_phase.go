@@ -19,9 +19,14 @@ func (client *Client) endOfRoundPhase(c *gin.Context, g *Game) ([]*contest.Conte
 	client.Log.Debugf(msgEnter)
 	defer client.Log.Debugf(msgExit)
 
-	g.Phase = EndOfRound
+	if err := g.setPhase(EndOfRound); err != nil {
+		return nil, err
+	}
 	g.placeNewOfficialsPhase(c)
-	completed := g.discardPhase(c)
+	completed, err := g.discardPhase(c)
+	if err != nil {
+		return nil, err
+	}
 	if completed {
 		g.returnActionCubesPhase(c)
 		return client.endOfGamePhase(c, g)
@@ -42,6 +47,9 @@ func (g *Game) placeNewOfficialIn(m *Ministry) {
 	for _, s := range []Seniority{1, 2, 6, 7} {
 		if _, ok := m.Officials[s]; !ok {
 			o := g.OfficialsDeck.Draw()
+			if o == nil {
+				return
+			}
 			o.Seniority = s
 			m.Officials[s] = o
 			return
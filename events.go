@@ -0,0 +1,136 @@
+package confucius
+
+import (
+	"github.com/SlothNinja/game"
+	"github.com/gin-gonic/gin"
+)
+
+// Event is a happening of a game.  Events emitted while a game is updated are dispatched to the
+// subscribers of the client once the game is saved, and are dropped where the update is undone.
+type Event interface {
+	EventName() string
+	info() *EventInfo
+}
+
+// EventInfo identifies the game, round and phase in which an event happened.  GameID is set on
+// dispatch, as a game started on creation has no id until saved.
+type EventInfo struct {
+	GameID int64
+	Round  int
+	Phase  game.Phase
+}
+
+func (i *EventInfo) info() *EventInfo {
+	return i
+}
+
+// ActionTaken is emitted when a player takes an action.
+type ActionTaken struct {
+	EventInfo
+	PlayerID int
+	Action   string
+}
+
+func (e *ActionTaken) EventName() string { return "ActionTaken" }
+
+// TurnFinished is emitted when a player finishes their turn.
+type TurnFinished struct {
+	EventInfo
+	PlayerID int
+}
+
+func (e *TurnFinished) EventName() string { return "TurnFinished" }
+
+// PhaseChanged is emitted when the game moves from one phase to another.  The phase entered is
+// the Phase of the event.
+type PhaseChanged struct {
+	EventInfo
+	From game.Phase
+}
+
+func (e *PhaseChanged) EventName() string { return "PhaseChanged" }
+
+// GiftGiven is emitted when a player gives a gift to another.
+type GiftGiven struct {
+	EventInfo
+	PlayerID    int
+	RecipientID int
+	Value       GiftCardValue
+	Canceled    bool // Whether the gift cancelled a gift the player received from the recipient
+}
+
+func (e *GiftGiven) EventName() string { return "GiftGiven" }
+
+// VoyageCompleted is emitted when a voyage of a player reaches a distant land.
+type VoyageCompleted struct {
+	EventInfo
+	PlayerID    int
+	DistantLand DistantLandID
+	Points      int
+}
+
+func (e *VoyageCompleted) EventName() string { return "VoyageCompleted" }
+
+// MinistryResolved is emitted when a ministry is resolved.
+type MinistryResolved struct {
+	EventInfo
+	Ministry    string
+	MinisterID  int
+	SecretaryID int
+}
+
+func (e *MinistryResolved) EventName() string { return "MinistryResolved" }
+
+// InvasionResolved is emitted when the invasion of a foreign land is resolved.
+type InvasionResolved struct {
+	EventInfo
+	ForeignLand ForeignLandID
+	Successful  bool
+	PlayerIDS   []int // Players having armies in the land
+}
+
+func (e *InvasionResolved) EventName() string { return "InvasionResolved" }
+
+// GameEnded is emitted when the game is over.
+type GameEnded struct {
+	EventInfo
+	WinnerIDS []int
+}
+
+func (e *GameEnded) EventName() string { return "GameEnded" }
+
+// emit records the event, to be dispatched once the game is saved.
+func (g *Game) emit(e Event) {
+	i := e.info()
+	i.Round, i.Phase = g.Round, g.Phase
+	g.events = append(g.events, e)
+}
+
+// Subscriber acts upon an event of the saved game.
+type Subscriber func(c *gin.Context, g *Game, e Event) error
+
+// Subscribe registers the subscriber to receive the events of each game saved by the client.
+func (client *Client) Subscribe(s Subscriber) {
+	client.subscribers = append(client.subscribers, s)
+}
+
+// dispatch passes the events emitted since the game was last saved to each subscriber.  Errors of
+// subscribers are logged, as the game is already saved.
+func (client *Client) dispatch(c *gin.Context, g *Game) {
+	es := g.events
+	g.events = nil
+	for _, e := range es {
+		e.info().GameID = g.ID()
+		for _, s := range client.subscribers {
+			if err := s(c, g, e); err != nil {
+				client.Log.Errorf("game %d: %s subscriber: %s", g.ID(), e.EventName(), err)
+			}
+		}
+	}
+}
+
+// logEvent logs each event for debugging.
+func (client *Client) logEvent(c *gin.Context, g *Game, e Event) error {
+	client.Log.Debugf("game %d: %s %+v", g.ID(), e.EventName(), e)
+	return nil
+}
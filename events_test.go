@@ -0,0 +1,138 @@
+package confucius
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/codec"
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/sn"
+	"github.com/gin-gonic/gin"
+)
+
+// newEventsClient returns a client recording the names of the events it dispatches.
+func newEventsClient() (*Client, *[]string) {
+	client := &Client{Client: &sn.Client{Log: new(log.Logger)}}
+	names := new([]string)
+	client.Subscribe(func(c *gin.Context, g *Game, e Event) error {
+		*names = append(*names, e.EventName())
+		return nil
+	})
+	return client, names
+}
+
+// reloadTestGame returns the game as loaded from the datastore once saved, as where its cached game
+// is discarded.
+func reloadTestGame(t *testing.T, c *gin.Context, g *Game) *Game {
+	t.Helper()
+
+	ps, err := datastore.SaveStruct(g.Header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g2 := New(c, g.ID())
+	if err := datastore.LoadStruct(g2.Header, ps); err != nil {
+		t.Fatal(err)
+	}
+	g2.Users = g.Users
+
+	s := newState()
+	if err := codec.Decode(&s, g2.SavedState); err != nil {
+		t.Fatal(err)
+	}
+	g2.State = s
+	linkTestGame(g2)
+	return g2
+}
+
+// TestCachedActionEvents checks that the events of an action cached until the turn is finished are
+// dispatched once the turn is saved, and only once, including where finishing the turn first fails.
+func TestCachedActionEvents(t *testing.T) {
+	client, names := newEventsClient()
+	c, g := newTestGame(t, 3)
+	g.events = nil // Dispatched as the game was created.
+	cp := g.CurrentPlayer()
+	cp.ActionCubes = 0 // So that the player may pass.
+	cu := g.Users[cp.ID()]
+
+	// The cache keeps the game itself, so its events stay with the cached game.
+	postForm(c, url.Values{"action": {"pass"}})
+	if _, actionType, err := g.Update(c, cu); err != nil || actionType != game.Cache {
+		t.Fatalf("pass: got %v and %v, want %v", actionType, err, game.Cache)
+	}
+
+	// A failed finish drops its own events, but not those of the cached action.
+	if _, _, err := client.finishTurn(c, g, g.Users[g.nextPlayer().ID()]); err == nil {
+		t.Fatal("finish turn by another player: got no error")
+	}
+	if got := len(g.events); got != 1 {
+		t.Errorf("events after failed finish: got %d, want 1", got)
+	}
+
+	if _, _, err := client.finishTurn(c, g, cu); err != nil {
+		t.Fatal(err)
+	}
+	if len(*names) != 0 {
+		t.Fatalf("events dispatched before the turn is saved: %v", *names)
+	}
+
+	// As saving the turn does.
+	client.dispatch(c, g)
+	client.dispatch(c, g)
+	counts := make(map[string]int)
+	for _, name := range *names {
+		counts[name]++
+	}
+	for _, name := range []string{"ActionTaken", "TurnFinished"} {
+		if counts[name] != 1 {
+			t.Errorf("%s dispatched: got %d, want 1", name, counts[name])
+		}
+	}
+	if len(g.events) != 0 {
+		t.Errorf("events left after dispatch: got %d, want 0", len(g.events))
+	}
+}
+
+// TestRewoundActionEvents checks that the events of a cached action are dropped where the turn is
+// undone or reset, as the cached game is discarded for the one saved.
+func TestRewoundActionEvents(t *testing.T) {
+	for _, action := range []string{"undo", "reset"} {
+		t.Run(action, func(t *testing.T) {
+			client, names := newEventsClient()
+			c, g := newTestGame(t, 3)
+			g.events = nil // Dispatched as the game was created.
+			cp := g.CurrentPlayer()
+			cp.ActionCubes = 0 // So that the player may pass.
+			if err := g.encode(c); err != nil {
+				t.Fatal(err)
+			}
+			cu := g.Users[cp.ID()]
+
+			postForm(c, url.Values{"action": {"pass"}})
+			if _, _, err := g.Update(c, cu); err != nil {
+				t.Fatal(err)
+			}
+
+			// Undo is a route of its own, whereas a reset is an update discarding the cached game.
+			if action == "reset" {
+				c, _ = gin.CreateTestContext(httptest.NewRecorder())
+				postForm(c, url.Values{"action": {"reset"}})
+				if _, actionType, err := g.Update(c, cu); err != nil || actionType != game.Reset {
+					t.Fatalf("reset: got %v and %v, want %v", actionType, err, game.Reset)
+				}
+			}
+
+			g = reloadTestGame(t, c, g)
+			if g.CurrentPlayer().Passed {
+				t.Fatal("reloaded game has the cached pass")
+			}
+			client.dispatch(c, g)
+			if len(*names) != 0 {
+				t.Errorf("events dispatched: got %v, want none", *names)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package confucius
 
 import (
 	"encoding/gob"
-	"fmt"
 	"html/template"
 
 	"github.com/SlothNinja/game"
@@ -17,16 +16,17 @@ func init() {
 
 // Returns true if no further player actions are needed in order
 // to resolve examination phase.
-func (g *Game) examinationPhase(c *gin.Context, cu *user.User) bool {
+func (g *Game) examinationPhase(c *gin.Context, cu *user.User) (bool, error) {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = ImperialExamination
+	if err := g.setPhase(ImperialExamination); err != nil {
+		return false, err
+	}
 
 	switch {
 	case g.canResolveExamination():
-		g.resolveExamination()
-		return false
+		return false, g.resolveExamination()
 	case g.canHoldExamination():
 		// Select player before Chief Minister, so nextplayer selects Chief Minister.
 		// This seems round-about, but it triggers the logic to skip nextplayers(s), if he has no cards to play.
@@ -38,12 +38,11 @@ func (g *Game) examinationPhase(c *gin.Context, cu *user.User) bool {
 		p := g.tutorStudentsPhaseNextPlayer(g.PlayerByIndex(i))
 		if p != nil {
 			g.SetCurrentPlayerers(p)
-			return false
+			return false, nil
 		}
-		g.resolveExamination()
-		return false
+		return false, g.resolveExamination()
 	}
-	return true
+	return true, nil
 }
 
 func (g *Game) canHoldExamination() bool {
@@ -70,33 +69,43 @@ func (g *Game) examinationForced() bool {
 	return g.ActionSpaces[ForceSpace].CubeCount() > 0
 }
 
-func (g *Game) resolveExamination() {
+func (g *Game) resolveExamination() error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = ExaminationResolution
-	g.beginningOfPhaseReset()
+	if err := g.setPhase(ExaminationResolution); err != nil {
+		return err
+	}
 
 	can := g.Candidate()
 	if can.hasOnePlayer() || can.hasTwoSamePlayers() {
 		cp := can.Player()
-		g.SetCurrentPlayerers(cp)
+		if cp == nil {
+			cp = can.OtherPlayer()
+		}
 		cp.newStudentPromotionEntry(nil, nil, nil, false)
-		return
+		if cp.isNeutral() {
+			g.neutralPlaceStudent()
+			return nil
+		}
+		g.SetCurrentPlayerers(cp)
+		return nil
 	}
 
+	g.neutralTutor(can)
+
 	var winningCards, losingCards ConCards
-	var loser *Player
+	var winner, loser *Player
 
 	coins0 := can.PlayerCards.Coins()
 	coins1 := can.OtherPlayerCards.Coins()
 	if coins0 >= coins1 {
-		g.SetCurrentPlayerers(can.Player())
+		winner = can.Player()
 		winningCards = can.PlayerCards
 		losingCards = can.OtherPlayerCards
 		loser = can.OtherPlayer()
 	} else {
-		g.SetCurrentPlayerers(can.OtherPlayer())
+		winner = can.OtherPlayer()
 		winningCards = can.OtherPlayerCards
 		losingCards = can.PlayerCards
 		loser = can.Player()
@@ -104,8 +113,13 @@ func (g *Game) resolveExamination() {
 	// Move played cards to discard pile
 	g.ConDiscardPile.Append(winningCards...)
 	g.ConDiscardPile.Append(losingCards...)
-	cp := g.CurrentPlayer()
-	cp.newStudentPromotionEntry(loser, winningCards, losingCards, true)
+	winner.newStudentPromotionEntry(loser, winningCards, losingCards, true)
+	if winner.isNeutral() {
+		g.neutralPlaceStudent()
+		return nil
+	}
+	g.SetCurrentPlayerers(winner)
+	return nil
 }
 
 type studentPromotionEntry struct {
@@ -134,20 +148,21 @@ func (p *Player) newStudentPromotionEntry(player *Player, wcards, lcards ConCard
 }
 
 func (e *studentPromotionEntry) HTML() template.HTML {
+	l := e.locale()
 	var s string
 	if e.Contested {
-		s = fmt.Sprintf("<div>%s won the Imperial Examination.</div>", e.Player().Name())
+		s = "<div>" + l.Sprintf("entry.examination-won", e.Player().Name()) + "</div>"
 
 		winningLength := len(e.WinningCards)
-		s += fmt.Sprintf("<div>The student of %s received %d coins on %d %s.</div>",
-			e.Player().Name(), e.WinningCards.Coins(), winningLength, pluralize("card", winningLength))
+		s += "<div>" + l.Sprintf("entry.examination-student-coins",
+			e.Player().Name(), e.WinningCards.Coins(), winningLength, l.Plural("card", winningLength)) + "</div>"
 
 		losingLength := len(e.LosingCards)
-		s += fmt.Sprintf("<div>The student of %s received %d coins on %d %s.</div>",
-			e.OtherPlayer().Name(), e.LosingCards.Coins(), losingLength, pluralize("card", losingLength))
+		s += "<div>" + l.Sprintf("entry.examination-student-coins",
+			e.OtherPlayer().Name(), e.LosingCards.Coins(), losingLength, l.Plural("card", losingLength)) + "</div>"
 		return template.HTML(s)
 	}
-	return template.HTML(fmt.Sprintf("<div>%s won the Imperial Examination uncontested.</div>", e.Player().Name()))
+	return template.HTML("<div>" + l.Sprintf("entry.examination-uncontested", e.Player().Name()) + "</div>")
 }
 
 //func (g *Game) oneStudent() bool {
@@ -163,5 +178,15 @@ func (e *studentPromotionEntry) HTML() template.HTML {
 //}
 
 func (g *Game) EnableTutorStudent(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.Phase == ImperialExamination && !g.CurrentPlayer().PerformedAction
+	return g.TutorStudentReasons(cu).None()
+}
+
+// TutorStudentReasons returns why the user cu may not tutor a student.
+func (g *Game) TutorStudentReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.phase(ImperialExamination).notPerformed(cp)
+	return r.reasons
 }
@@ -28,78 +28,136 @@ func (client *Client) finish(prefix string) gin.HandlerFunc {
 		}
 
 		oldCP := g.CurrentPlayer()
+		seen := len(g.Log)
 
-		var (
-			s  *user.Stats
-			cs []*contest.Contest
-		)
-
-		switch g.Phase {
-		case Actions:
-			s, err = g.actionsPhaseFinishTurn(c, cu)
-		case ImperialFavour:
-			s, cs, err = client.imperialFavourFinishTurn(c, g, cu)
-		case ChooseChiefMinister:
-			s, err = g.chooseChiefMinisterPhaseFinishTurn(c, cu)
-		case Discard:
-			s, cs, err = client.discardPhaseFinishTurn(c, g, cu)
-		case ImperialExamination:
-			s, err = g.tutorStudentsPhaseFinishTurn(c, cu)
-		case ExaminationResolution:
-			s, cs, err = client.examinationResolutionFinishTurn(c, g, cu)
-		case MinistryResolution:
-			s, cs, err = client.ministryResolutionFinishTurn(c, g, cu)
-		default:
-			err = sn.NewVError("Improper Phase for finishing turn.")
-		}
-
+		s, cs, err := client.finishTurn(c, g, cu)
 		if err != nil {
 			client.Log.Errorf(err.Error())
+			if wantsJSON(c) && sn.IsVError(err) {
+				c.JSON(http.StatusOK, gin.H{"Errors": []string{err.Error()}})
+				return
+			}
 			c.Redirect(http.StatusSeeOther, showPath(c, prefix))
 			return
 		}
+		oldCP.markSeen(seen)
 
-		// cs != nil then game over
-		if cs != nil {
-			g.Phase = GameOver
-			g.Status = game.Completed
-			ks, es := wrap(s.GetUpdate(c, g.UpdatedAt), cs)
-			err = client.saveWith(c, g, cu, ks, es)
-			if err != nil {
-				client.Log.Errorf(err.Error())
-				c.Redirect(http.StatusSeeOther, showPath(c, prefix))
-				return
-			}
+		err = client.saveTurn(c, g, cu, oldCP, s, cs)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+		}
+		c.Redirect(http.StatusSeeOther, showPath(c, prefix))
+	}
+}
 
-			err = g.SendEndGameNotifications(c)
-			if err != nil {
-				client.Log.Errorf(err.Error())
-			}
+// finishTurn finishes the turn of the user cu in the present phase, returning the contests of the
+// game where it ends.
+func (client *Client) finishTurn(c *gin.Context, g *Game, cu *user.User) (*user.Stats, []*contest.Contest, error) {
+	var (
+		s   *user.Stats
+		cs  []*contest.Contest
+		err error
+	)
+
+	// Emitted first, so the turn precedes the happenings it leads to.
+	n := len(g.events)
+	if cp := g.CurrentPlayer(); cp != nil {
+		g.emit(&TurnFinished{PlayerID: cp.ID()})
+	}
 
-			c.Redirect(http.StatusSeeOther, showPath(c, prefix))
-			return
-		}
+	switch g.Phase {
+	case Actions:
+		s, err = g.actionsPhaseFinishTurn(c, cu)
+	case ImperialFavour:
+		s, cs, err = client.imperialFavourFinishTurn(c, g, cu)
+	case ChooseChiefMinister:
+		s, err = g.chooseChiefMinisterPhaseFinishTurn(c, cu)
+	case Discard:
+		s, cs, err = client.discardPhaseFinishTurn(c, g, cu)
+	case ImperialExamination:
+		s, err = g.tutorStudentsPhaseFinishTurn(c, cu)
+	case ExaminationResolution:
+		s, cs, err = client.examinationResolutionFinishTurn(c, g, cu)
+	case MinistryResolution, FinalMinistryResolution:
+		s, cs, err = client.ministryResolutionFinishTurn(c, g, cu)
+	default:
+		err = g.vError("error.improper-phase-finishing-turn")
+	}
 
-		// Game not over
+	if err != nil {
+		g.events = g.events[:n]
+	}
+	return s, cs, err
+}
+
+// saveTurn saves the game once a turn is finished, updating the stats s of the user finishing the
+// turn, if any.  Contests cs end the game.  Otherwise, the new current player is notified.
+func (client *Client) saveTurn(c *gin.Context, g *Game, cu *user.User, oldCP *Player, s *user.Stats,
+	cs []*contest.Contest) error {
+	g.CommitPoint = nil
+
+	var (
+		ks []*datastore.Key
+		es []interface{}
+	)
+	if s != nil {
 		s = s.GetUpdate(c, g.UpdatedAt)
-		err = client.saveWith(c, g, cu, []*datastore.Key{s.Key}, []interface{}{s})
+		ks, es = []*datastore.Key{s.Key}, []interface{}{s}
+	}
+
+	// cs != nil then game over
+	if cs != nil {
+		if err := g.setPhase(GameOver); err != nil {
+			return err
+		}
+		for _, ct := range cs {
+			ks, es = append(ks, ct.Key), append(es, ct)
+		}
+		if r := g.soloResult(); r != nil {
+			ks, es = append(ks, r.Key), append(es, r)
+		}
+
+		// The tournament advances in the transaction saving the game, so neither is saved without the other.
+		a, err := client.newTournamentAdvance(c, g)
+		if err != nil {
+			return err
+		}
+
+		var puts []txPut
+		if a != nil {
+			puts = append(puts, a.put)
+		}
+
+		err = client.saveWith(c, g, cu, ks, es, puts...)
+		if err != nil {
+			return err
+		}
+
+		err = g.SendEndGameNotifications(c)
 		if err != nil {
 			client.Log.Errorf(err.Error())
-			c.Redirect(http.StatusSeeOther, showPath(c, prefix))
-			return
 		}
 
-		newCP := g.CurrentPlayer()
-		if newCP != nil && oldCP.ID() != newCP.ID() {
-			err = g.SendTurnNotificationsTo(c, newCP)
-			if err != nil {
-				client.Log.Errorf(err.Error())
-			}
+		if a != nil {
+			a.notify()
 		}
+		return nil
+	}
 
-		c.Redirect(http.StatusSeeOther, showPath(c, prefix))
-		return
+	// Game not over
+	err := client.saveWith(c, g, cu, ks, es)
+	if err != nil {
+		return err
 	}
+
+	newCP := g.CurrentPlayer()
+	if newCP != nil && (oldCP == nil || oldCP.ID() != newCP.ID()) {
+		err = g.SendTurnNotificationsTo(c, newCP)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+		}
+	}
+	return nil
 }
 
 func (g *Game) validateFinishTurn(c *gin.Context, cu *user.User) (*user.Stats, error) {
@@ -111,9 +169,9 @@ func (g *Game) validateFinishTurn(c *gin.Context, cu *user.User) (*user.Stats, e
 	s := user.StatsFetched(c)
 	switch {
 	case !g.IsCurrentPlayer(cu):
-		return nil, sn.NewVError("Only the current player may finish a turn.")
+		return nil, g.vError("error.only-current-player-may-finish-turn")
 	case !cp.PerformedAction:
-		return nil, sn.NewVError("%s has yet to perform an action.", g.NameFor(cp))
+		return nil, g.vError("error.yet-perform-action", g.NameFor(cp))
 	default:
 		return s, nil
 	}
@@ -122,10 +180,11 @@ func (g *Game) validateFinishTurn(c *gin.Context, cu *user.User) (*user.Stats, e
 // ps is an optional parameter.
 // If no player is provided, assume current player.
 func (g *Game) nextPlayer(ps ...*Player) *Player {
-	i := game.IndexFor(g.CurrentPlayer(), g.Playerers) + 1
+	p := g.CurrentPlayer()
 	if len(ps) == 1 {
-		i = game.IndexFor(ps[0], g.Playerers) + 1
+		p = ps[0]
 	}
+	i := game.IndexFor(p, g.Playerers) + 1
 	return g.Players()[i%g.NumPlayers]
 }
 
@@ -140,7 +199,8 @@ func (g *Game) actionsPhaseFinishTurn(c *gin.Context, cu *user.User) (*user.Stat
 	}
 
 	cp := g.CurrentPlayer()
-	restful.AddNoticef(c, "%s finished turn.", g.NameFor(cp))
+	cp.resolvePendingVoyages()
+	restful.AddNoticef(c, "%s", g.Locale().Sprintf("notice.finished-turn", g.NameFor(cp)))
 
 	// Reveal Cards
 	cp.ConCardHand.Reveal()
@@ -155,7 +215,9 @@ func (g *Game) actionsPhaseFinishTurn(c *gin.Context, cu *user.User) (*user.Stat
 		return s, nil
 	}
 
-	g.imperialFavourPhase()
+	if err := g.imperialFavourPhase(); err != nil {
+		return nil, err
+	}
 	return s, nil
 }
 
@@ -188,23 +250,34 @@ func (client *Client) imperialFavourFinishTurn(c *gin.Context, g *Game, cu *user
 	}
 
 	cp := g.CurrentPlayer()
-	restful.AddNoticef(c, "%s finished turn.", g.NameFor(cp))
+	cp.resolvePendingVoyages()
+	restful.AddNoticef(c, "%s", g.Locale().Sprintf("notice.finished-turn", g.NameFor(cp)))
 
 	// Reveal Cards
 	cp.ConCardHand.Reveal()
 	cp.EmperorHand.Reveal()
 
-	g.buildWallPhase()
-	completed := g.examinationPhase(c, cu)
+	if err := g.buildWallPhase(); err != nil {
+		return nil, nil, err
+	}
+	completed, err := g.examinationPhase(c, cu)
+	if err != nil {
+		return nil, nil, err
+	}
 	if !completed {
 		return s, nil, nil
 	}
 
-	completed = g.ministryResolutionPhase(c, false)
+	completed, err = g.ministryResolutionPhase(c, false)
+	if err != nil {
+		return nil, nil, err
+	}
 	if !completed {
 		return s, nil, nil
 	}
-	g.invasionPhase(c)
+	if err := g.invasionPhase(c); err != nil {
+		return nil, nil, err
+	}
 	cs, err := client.endOfRoundPhase(c, g)
 	return s, cs, err
 }
@@ -222,7 +295,9 @@ func (g *Game) chooseChiefMinisterPhaseFinishTurn(c *gin.Context, cu *user.User)
 		p.PerformedAction = false
 	}
 	g.SetCurrentPlayerers(g.nextPlayer(g.ChiefMinister()))
-	g.actionsPhase()
+	if err := g.actionsPhase(); err != nil {
+		return nil, err
+	}
 	return s, nil
 }
 
@@ -248,12 +323,17 @@ func (client *Client) examinationResolutionFinishTurn(c *gin.Context, g *Game, c
 		}
 	}
 	g.Candidates = g.Candidates[i:]
-	completed := g.ministryResolutionPhase(c, false)
+	completed, err := g.ministryResolutionPhase(c, false)
+	if err != nil {
+		return nil, nil, err
+	}
 	if !completed {
 		return s, nil, nil
 	}
 
-	g.invasionPhase(c)
+	if err := g.invasionPhase(c); err != nil {
+		return nil, nil, err
+	}
 	cs, err := client.endOfRoundPhase(c, g)
 	return s, cs, err
 }
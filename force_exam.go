@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -59,9 +58,10 @@ func (p *Player) newForceExamEntry(cards ConCards) *forceExamEntry {
 }
 
 func (e *forceExamEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
-	return restful.HTML("%s spent %d %s having %d coins to force an examination.",
-		e.Player().Name(), length, pluralize("card", length), e.Played.Coins())
+	return l.HTML("entry.force-exam",
+		e.Player().Name(), length, l.Plural("card", length), e.Played.Coins())
 }
 
 func (g *Game) validateForceExam(c *gin.Context, cu *user.User) (ConCards, int, error) {
@@ -83,19 +83,30 @@ func (g *Game) validateForceExam(c *gin.Context, cu *user.User) (ConCards, int,
 
 	switch {
 	case g.Round == 1:
-		return nil, 0, sn.NewVError("You cannot force an examination during round %d.", g.Round)
+		return nil, 0, g.vError("error.cannot-force-examination-during-round", g.Round)
 	case !cp.canAffordForceExam():
-		return nil, 0, sn.NewVError("You selected cards having %d total coins, but you need 2 coins to force an examination.", coinValue)
+		return nil, 0, g.vError("error.insufficient-coins-force-exam", coinValue)
 	}
 	return cards, cubes, nil
 }
 
 func (g *Game) EnableForceExam(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.inActionsOrImperialFavourPhase() && cp != nil && g.IsCurrentPlayer(cu) &&
-		g.Round > 1 && !cp.PerformedAction && cp.hasEnoughCubesFor(ForceSpace) && cp.canAffordForceExam()
+	return g.ForceExamReasons(cu).None()
 }
 
+// ForceExamReasons returns why the user cu may not force an examination.
+func (g *Game) ForceExamReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().afterFirstRound().notPerformed(cp).cubes(cp, ForceSpace).coins(cp, forceExamCost)
+	return r.reasons
+}
+
+// forceExamCost is the cost in coins of forcing an examination.
+const forceExamCost = 2
+
 func (p *Player) canAffordForceExam() bool {
-	return p.ConCardHand.Coins() >= 2
+	return p.ConCardHand.Coins() >= forceExamCost
 }
@@ -0,0 +1,385 @@
+package confucius
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+)
+
+// influence maps the seniority of each official of a ministry to the id of the player holding
+// the official's influence during resolution.  It allows resolution to be simulated without
+// changing the game.
+type influence map[Seniority]int
+
+// tempInfluence returns the temporary influence of a ministry being resolved.
+func (m *Ministry) tempInfluence() influence {
+	inf := make(influence)
+	for seniority, o := range m.Officials {
+		if o.TempID != NoPlayerID {
+			inf[seniority] = o.TempID
+		}
+	}
+	return inf
+}
+
+// bribedInfluence returns the influence with which resolution of the ministry would begin.
+func (m *Ministry) bribedInfluence() influence {
+	inf := make(influence)
+	for seniority, o := range m.Officials {
+		if o.PlayerID != NoPlayerID {
+			inf[seniority] = o.PlayerID
+		}
+	}
+	return inf
+}
+
+func (inf influence) copy() influence {
+	cp := make(influence, len(inf))
+	for seniority, pid := range inf {
+		cp[seniority] = pid
+	}
+	return cp
+}
+
+// seniorities returns the seniorities of the held officials, the most senior first.
+func (inf influence) seniorities() Seniorities {
+	ss := make(Seniorities, 0, len(inf))
+	for seniority := range inf {
+		ss = append(ss, seniority)
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i] < ss[j] })
+	return ss
+}
+
+func (inf influence) counts() map[int]int {
+	counts := make(map[int]int)
+	for _, pid := range inf {
+		counts[pid] += 1
+	}
+	return counts
+}
+
+// seniorID returns the id of the player holding the most senior official.
+func (inf influence) seniorID() int {
+	for _, seniority := range inf.seniorities() {
+		return inf[seniority]
+	}
+	return NoPlayerID
+}
+
+// lowestID returns the id of the player having the least influence, who must transfer it.
+// The tied player holding the most senior official keeps their influence.
+func (inf influence) lowestID(counts map[int]int) int {
+	min := 7
+	var pids []int
+
+	for pid, count := range counts {
+		if count == min {
+			pids = append(pids, pid)
+		} else if count < min {
+			min = count
+			pids = []int{pid}
+		}
+	}
+
+	if len(pids) == 1 {
+		return pids[0]
+	}
+
+	for _, seniority := range inf.seniorities() {
+		for i, pid := range pids {
+			if inf[seniority] == pid {
+				pids = append(pids[:i], pids[i+1:]...)
+			}
+			if len(pids) == 1 {
+				return pids[0]
+			}
+		}
+	}
+	return NoPlayerID
+}
+
+// appointments returns the ids of the minister and secretary, once no more than two players hold influence.
+func (inf influence) appointments(counts map[int]int) (int, int) {
+	ministerID, secretaryID := NoPlayerID, NoPlayerID
+	switch len(counts) {
+	case 1:
+		for pid := range counts {
+			ministerID, secretaryID = pid, pid
+		}
+	case 2:
+		var ministerCount int
+		for pid, count := range counts {
+			switch {
+			case ministerCount == 0:
+				ministerID, ministerCount = pid, count
+			case ministerCount > count:
+				secretaryID = pid
+			case ministerCount < count:
+				secretaryID = ministerID
+				ministerID, ministerCount = pid, count
+			case ministerCount == count:
+				switch inf.seniorID() {
+				case pid:
+					secretaryID = ministerID
+					ministerID, ministerCount = pid, count
+				default:
+					secretaryID = pid
+				}
+			}
+		}
+	}
+	return ministerID, secretaryID
+}
+
+func (inf influence) transfer(from, to int) {
+	for seniority, pid := range inf {
+		if pid == from {
+			inf[seniority] = to
+		}
+	}
+}
+
+// giftObligations maps the id of each gift recipient to the value of the gift received from each giver.
+type giftObligations map[int]map[int]GiftCardValue
+
+func (g *Game) giftObligations() giftObligations {
+	obs := make(giftObligations)
+	for _, p := range g.Players() {
+		for _, gift := range p.GiftsReceived {
+			giver := gift.Player()
+			if giver == nil {
+				continue
+			}
+			if obs[p.ID()] == nil {
+				obs[p.ID()] = make(map[int]GiftCardValue)
+			}
+			obs[p.ID()][giver.ID()] = gift.Value
+		}
+	}
+	return obs
+}
+
+func (obs giftObligations) copy() giftObligations {
+	cp := make(giftObligations, len(obs))
+	for recipient, givers := range obs {
+		cp[recipient] = make(map[int]GiftCardValue, len(givers))
+		for giver, value := range givers {
+			cp[recipient][giver] = value
+		}
+	}
+	return cp
+}
+
+// targets returns the ids of the players to whom the player may transfer influence.  A player is
+// obliged to transfer to the holder of the most valuable gift received from a player holding
+// influence, and otherwise may transfer to any other player holding influence.
+func (inf influence) targets(pid int, obs giftObligations) []int {
+	counts := inf.counts()
+
+	var ids []int
+	value := NoGiftValue
+	for giver, v := range obs[pid] {
+		if counts[giver] == 0 {
+			continue
+		}
+		switch {
+		case v == value:
+			ids = append(ids, giver)
+		case v > value:
+			ids = []int{giver}
+			value = v
+		}
+	}
+
+	if len(ids) == 0 {
+		for id := range counts {
+			if id != pid {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// neutralTarget returns the id of the player to whom the neutral family transfers its influence:
+// the player having the most influence, ties going to the player holding the more senior official.
+func (inf influence) neutralTarget(ids []int) int {
+	counts := inf.counts()
+	target := NoPlayerID
+	for _, seniority := range inf.seniorities() {
+		pid := inf[seniority]
+		if includeID(ids, pid) && (target == NoPlayerID || counts[pid] > counts[target]) {
+			target = pid
+		}
+	}
+	return target
+}
+
+func includeID(ids []int, id int) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ForecastChoice records a transfer chosen by a player.
+type ForecastChoice struct {
+	PlayerID int
+	ToID     int
+}
+
+// ForecastDecision records a point at which a player must choose to whom influence is transferred.
+type ForecastDecision struct {
+	PlayerID  int
+	OptionIDS []int
+}
+
+// ForecastOutcome provides the result of resolution following a sequence of choices.
+type ForecastOutcome struct {
+	Choices         []*ForecastChoice
+	MinisterID      int
+	SecretaryID     int
+	MinisterPoints  int
+	SecretaryPoints int
+}
+
+// MinistryForecast predicts the result of resolving a ministry now.  Minister and secretary are
+// those of the outcome reached by the most sequences of choices, the first such outcome breaking ties.
+type MinistryForecast struct {
+	Ministry        string
+	MinisterID      int
+	SecretaryID     int
+	MinisterPoints  int
+	SecretaryPoints int
+	Decisions       []*ForecastDecision
+	Outcomes        []*ForecastOutcome
+}
+
+// Forecast simulates the resolution of the ministry from its current influence and gift obligations.
+func (m *Ministry) Forecast() *MinistryForecast {
+	g := m.Game()
+	f := &MinistryForecast{
+		Ministry:    m.Name(),
+		MinisterID:  NoPlayerID,
+		SecretaryID: NoPlayerID,
+	}
+
+	if m.Resolved {
+		f.MinisterID, f.SecretaryID = m.MinisterID, m.SecretaryID
+		return f
+	}
+
+	inf := m.bribedInfluence()
+	if m.InProgress {
+		inf = m.tempInfluence()
+	}
+
+	seen := make(map[string]bool)
+	f.forecast(m, inf, g.giftObligations(), nil, seen)
+
+	tallies := make(map[[2]int]int)
+	best := 0
+	for _, o := range f.Outcomes {
+		key := [2]int{o.MinisterID, o.SecretaryID}
+		tallies[key] += 1
+		if tallies[key] > best {
+			best = tallies[key]
+			f.MinisterID, f.SecretaryID = o.MinisterID, o.SecretaryID
+			f.MinisterPoints, f.SecretaryPoints = o.MinisterPoints, o.SecretaryPoints
+		}
+	}
+	return f
+}
+
+// forecast follows the transfer cascade of advanceResolution, branching wherever a player has a choice.
+func (f *MinistryForecast) forecast(m *Ministry, inf influence, obs giftObligations, choices []*ForecastChoice,
+	seen map[string]bool) {
+	for counts := inf.counts(); len(counts) > 2; counts = inf.counts() {
+		from := inf.lowestID(counts)
+		if from == NoPlayerID {
+			f.addOutcome(m, NoPlayerID, NoPlayerID, choices)
+			return
+		}
+
+		ids := inf.targets(from, obs)
+		if p := m.Game().PlayerByID(from); p.isNeutral() {
+			ids = []int{inf.neutralTarget(ids)}
+		}
+
+		switch len(ids) {
+		case 0:
+			f.addOutcome(m, NoPlayerID, NoPlayerID, choices)
+			return
+		case 1:
+			transferInfluence(inf, obs, from, ids[0])
+		default:
+			key := fmt.Sprintf("%d:%v", from, ids)
+			if !seen[key] {
+				seen[key] = true
+				f.Decisions = append(f.Decisions, &ForecastDecision{PlayerID: from, OptionIDS: ids})
+			}
+			for _, to := range ids {
+				branchInf, branchObs := inf.copy(), obs.copy()
+				transferInfluence(branchInf, branchObs, from, to)
+				branchChoices := append(append([]*ForecastChoice{}, choices...), &ForecastChoice{PlayerID: from, ToID: to})
+				f.forecast(m, branchInf, branchObs, branchChoices, seen)
+			}
+			return
+		}
+	}
+
+	ministerID, secretaryID := inf.appointments(inf.counts())
+	f.addOutcome(m, ministerID, secretaryID, choices)
+}
+
+// transferInfluence transfers influence as transferTempInfluenceTo does, cancelling any gift the
+// transferring player received from the player receiving the influence.
+func transferInfluence(inf influence, obs giftObligations, from, to int) {
+	inf.transfer(from, to)
+	delete(obs[from], to)
+}
+
+func (f *MinistryForecast) addOutcome(m *Ministry, ministerID, secretaryID int, choices []*ForecastChoice) {
+	o := &ForecastOutcome{
+		Choices:     choices,
+		MinisterID:  ministerID,
+		SecretaryID: secretaryID,
+	}
+	if ministerID != NoPlayerID {
+		o.MinisterPoints = m.MinisterChit.Value()
+	}
+	if secretaryID != NoPlayerID {
+		o.SecretaryPoints = m.SecretaryChit.Value()
+	}
+	f.Outcomes = append(f.Outcomes, o)
+}
+
+// MinistryForecasts returns a forecast for each unresolved ministry.
+func (g *Game) MinistryForecasts() []*MinistryForecast {
+	var fs []*MinistryForecast
+	for _, mid := range ministeryIDS {
+		if m := g.Ministries[mid]; m != nil && !m.Resolved {
+			fs = append(fs, m.Forecast())
+		}
+	}
+	return fs
+}
+
+func (client *Client) forecastJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Forecasts": g.MinistryForecasts()})
+}
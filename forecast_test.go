@@ -0,0 +1,305 @@
+package confucius
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func giveTestGift(g *Game, from, to int, value GiftCardValue) {
+	p := g.PlayerByID(to)
+	p.GiftsReceived.Append(&GiftCard{game: g, Value: value, PlayerID: from})
+}
+
+func TestInfluence(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		p2 = 2
+	)
+	tests := []struct {
+		name       string
+		inf        influence
+		wantSenior int
+		wantLowest int
+	}{
+		{
+			name:       "single lowest",
+			inf:        influence{1: p1, 2: p0, 3: p1, 4: p2, 5: p2},
+			wantSenior: p1,
+			wantLowest: p0,
+		},
+		{
+			name:       "tied player holding the most senior official keeps influence",
+			inf:        influence{1: p0, 2: p1, 3: p2, 4: p2},
+			wantSenior: p0,
+			wantLowest: p1,
+		},
+		{
+			name:       "three tied players",
+			inf:        influence{2: p2, 3: p0, 5: p1},
+			wantSenior: p2,
+			wantLowest: p1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := tt.inf.counts()
+			if got := tt.inf.seniorID(); got != tt.wantSenior {
+				t.Errorf("seniorID: got %d, want %d", got, tt.wantSenior)
+			}
+			if got := tt.inf.lowestID(counts); got != tt.wantLowest {
+				t.Errorf("lowestID: got %d, want %d", got, tt.wantLowest)
+			}
+		})
+	}
+}
+
+// TestLowestIDAlwaysChooses checks that a single player transfers influence wherever more than two
+// players hold influence in a ministry, whatever the ties, so resolution never awaits a choice of
+// the player transferring.
+func TestLowestIDAlwaysChooses(t *testing.T) {
+	const players, officials = 4, 6
+	holders := make([]int, officials)
+	for {
+		inf := make(influence)
+		for i, pid := range holders {
+			inf[Seniority(i+1)] = pid
+		}
+		if counts := inf.counts(); len(counts) > 2 {
+			if id := inf.lowestID(counts); id == NoPlayerID {
+				t.Fatalf("lowestID of %v: got none", inf)
+			}
+		}
+
+		i := 0
+		for ; i < officials && holders[i] == players-1; i++ {
+			holders[i] = 0
+		}
+		if i == officials {
+			return
+		}
+		holders[i]++
+	}
+}
+
+func TestInfluenceAppointments(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+	)
+	tests := []struct {
+		name          string
+		inf           influence
+		wantMinister  int
+		wantSecretary int
+	}{
+		{"one player holds both", influence{1: p0, 2: p0}, p0, p0},
+		{"most influence", influence{1: p1, 2: p0, 3: p0}, p0, p1},
+		{"tie goes to the more senior official", influence{1: p1, 2: p0, 3: p0, 4: p1}, p1, p0},
+		{"more than two players", influence{1: p0, 2: p1, 3: 2}, NoPlayerID, NoPlayerID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minister, secretary := tt.inf.appointments(tt.inf.counts())
+			if minister != tt.wantMinister || secretary != tt.wantSecretary {
+				t.Errorf("got %d and %d, want %d and %d", minister, secretary, tt.wantMinister, tt.wantSecretary)
+			}
+		})
+	}
+}
+
+func TestInfluenceTargets(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		p2 = 2
+		p3 = 3
+	)
+	inf := influence{1: p0, 2: p1, 3: p1, 4: p2, 5: p2, 6: p2}
+	tests := []struct {
+		name        string
+		obs         giftObligations
+		want        []int
+		wantNeutral int
+	}{
+		{"any player holding influence", nil, []int{p1, p2}, p2},
+		{"most valuable gift", giftObligations{p0: {p1: Vase, p2: Tile}}, []int{p1}, p1},
+		{"equally valuable gifts", giftObligations{p0: {p1: Vase, p2: Vase}}, []int{p1, p2}, p2},
+		{"gift from a player without influence", giftObligations{p0: {p3: Junk}}, []int{p1, p2}, p2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inf.targets(p0, tt.obs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("targets: got %v, want %v", got, tt.want)
+			}
+			if got := inf.neutralTarget(got); got != tt.wantNeutral {
+				t.Errorf("neutralTarget: got %d, want %d", got, tt.wantNeutral)
+			}
+		})
+	}
+}
+
+type testGift struct {
+	from, to int
+	value    GiftCardValue
+}
+
+// resolveByDefault resolves the ministry, making each awaited decision by default, and returns
+// the choices made.
+func resolveByDefault(t *testing.T, c *gin.Context, g *Game, m *Ministry) []*ForecastChoice {
+	t.Helper()
+
+	var choices []*ForecastChoice
+	done := g.initMinistryResolution(c, m)
+	for i := 0; !done; i++ {
+		d := g.Decision
+		if d == nil || i > len(m.Officials) {
+			t.Fatalf("resolution stopped, decision %+v", d)
+		}
+		choices = append(choices, &ForecastChoice{PlayerID: d.PlayerID, ToID: d.defaultOption(m)})
+		g.decideByDefault()
+		done = g.advanceResolution(m)
+	}
+	return choices
+}
+
+func TestForecastMatchesResolution(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		p2 = 2
+		p3 = 3
+	)
+	tests := []struct {
+		name          string
+		players       int
+		holders       map[Seniority]int
+		gifts         []testGift
+		wantDecisions []*ForecastDecision
+		wantOutcomes  int
+	}{
+		{
+			name:          "lowest player chooses",
+			players:       3,
+			holders:       map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p2, 5: p2, 6: p2},
+			wantDecisions: []*ForecastDecision{{PlayerID: p0, OptionIDS: []int{p1, p2}}},
+			wantOutcomes:  2,
+		},
+		{
+			name:         "gift obliges the transfer",
+			players:      3,
+			holders:      map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p2, 5: p2, 6: p2},
+			gifts:        []testGift{{p1, p0, Vase}},
+			wantOutcomes: 1,
+		},
+		{
+			name:          "equal gifts leave a choice",
+			players:       3,
+			holders:       map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p2, 5: p2, 6: p2},
+			gifts:         []testGift{{p1, p0, Vase}, {p2, p0, Vase}},
+			wantDecisions: []*ForecastDecision{{PlayerID: p0, OptionIDS: []int{p1, p2}}},
+			wantOutcomes:  2,
+		},
+		{
+			name:          "gift from a player without influence",
+			players:       4,
+			holders:       map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p2, 5: p2},
+			gifts:         []testGift{{p3, p0, Junk}},
+			wantDecisions: []*ForecastDecision{{PlayerID: p0, OptionIDS: []int{p1, p2}}},
+			wantOutcomes:  2,
+		},
+		{
+			name:         "tied lowest player holding the junior official transfers",
+			players:      3,
+			holders:      map[Seniority]int{1: p0, 2: p1, 3: p2, 4: p2},
+			gifts:        []testGift{{p2, p1, Tile}},
+			wantOutcomes: 1,
+		},
+		{
+			name:    "cascade of transfers",
+			players: 4,
+			holders: map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p2, 5: p2, 6: p3},
+			gifts:   []testGift{{p2, p0, Hanging}},
+			// p0 is obliged to transfer to p2, so leaves no choice.  Should p3 transfer to p0, three players tie, and p2, holding the least senior
+			// official, must transfer in turn.
+			wantDecisions: []*ForecastDecision{
+				{PlayerID: p3, OptionIDS: []int{p0, p1, p2}},
+				{PlayerID: p2, OptionIDS: []int{p0, p1}},
+			},
+			wantOutcomes: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g := newTestGame(t, tt.players)
+			m := g.Ministries[Bingbu]
+			costs := make(map[Seniority]int)
+			for seniority := range tt.holders {
+				costs[seniority] = 1
+			}
+			setOfficials(m, costs, tt.holders)
+			for _, gift := range tt.gifts {
+				giveTestGift(g, gift.from, gift.to, gift.value)
+			}
+
+			f := m.Forecast()
+			if !reflect.DeepEqual(f.Decisions, tt.wantDecisions) {
+				t.Errorf("decisions: got %v, want %v", f.Decisions, tt.wantDecisions)
+			}
+			if got := len(f.Outcomes); got != tt.wantOutcomes {
+				t.Errorf("outcomes: got %d, want %d", got, tt.wantOutcomes)
+			}
+
+			choices := resolveByDefault(t, c, g, m)
+			var outcome *ForecastOutcome
+			for _, o := range f.Outcomes {
+				if reflect.DeepEqual(o.Choices, choices) || len(o.Choices) == 0 && len(choices) == 0 {
+					outcome = o
+				}
+			}
+			switch {
+			case outcome == nil:
+				t.Fatalf("no outcome forecast for the choices %v", choices)
+			case !m.Resolved:
+				t.Fatal("ministry not resolved")
+			case outcome.MinisterID != m.MinisterID || outcome.SecretaryID != m.SecretaryID:
+				t.Errorf("forecast %d and %d, resolved %d and %d",
+					outcome.MinisterID, outcome.SecretaryID, m.MinisterID, m.SecretaryID)
+			}
+		})
+	}
+}
+
+func TestForecastNeutralFamily(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		n  = NeutralPlayerID
+	)
+	c, g := newTestGame(t, 2, TwoPlayerVariant)
+	m := g.Ministries[Bingbu]
+	holders := map[Seniority]int{1: p1, 2: p0, 3: p0, 4: p0, 5: p1, 6: n}
+	costs := make(map[Seniority]int)
+	for seniority := range holders {
+		costs[seniority] = 1
+	}
+	setOfficials(m, costs, holders)
+
+	// The neutral family decides by rule, so the forecast has a single outcome.
+	f := m.Forecast()
+	if len(f.Decisions) != 0 || len(f.Outcomes) != 1 {
+		t.Fatalf("got %d decisions and %d outcomes, want none and 1", len(f.Decisions), len(f.Outcomes))
+	}
+
+	resolveByDefault(t, c, g, m)
+	if f.MinisterID != m.MinisterID || f.SecretaryID != m.SecretaryID {
+		t.Errorf("forecast %d and %d, resolved %d and %d", f.MinisterID, f.SecretaryID, m.MinisterID, m.SecretaryID)
+	}
+}
@@ -135,14 +135,27 @@ func (lid ForeignLandID) CreateBoxes(land *ForeignLand) ForeignLandBoxes {
 	}
 }
 
+func newForeignLand(id ForeignLandID) *ForeignLand {
+	land := &ForeignLand{ID: id}
+	land.Boxes = id.CreateBoxes(land)
+	return land
+}
+
 func (g *Game) CreateForeignLands() {
+	// Use the lands fixed at creation, if any
+	if len(g.Setup.ForeignLands) > 0 {
+		selectedLands := make(ForeignLands, len(g.Setup.ForeignLands))
+		for i, id := range g.Setup.ForeignLands {
+			selectedLands[i] = newForeignLand(id)
+		}
+		g.ForeignLands = selectedLands
+		return
+	}
+
 	// Create Foreign Lands
 	lands := make(ForeignLands, len(foreignLandIDS))
 	for i, id := range foreignLandIDS {
-		land := new(ForeignLand)
-		lands[i] = land
-		lands[i].ID = id
-		lands[i].Boxes = id.CreateBoxes(land)
+		lands[i] = newForeignLand(id)
 	}
 
 	// Select three random lands for the game
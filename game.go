@@ -4,12 +4,12 @@ import (
 	"encoding/gob"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/SlothNinja/color"
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	gtype "github.com/SlothNinja/type"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
@@ -32,6 +32,9 @@ func (g *Game) GetPlayerers() game.Playerers {
 type Game struct {
 	*game.Header
 	*State
+
+	locale Locale
+	events []Event
 }
 
 type State struct {
@@ -61,8 +64,32 @@ type State struct {
 	Wall        int  `form:"wall"`
 	ExtraAction bool `form:"extra-action"`
 
+	// CommitPoint is the last action of the current turn that drew or revealed hidden information.
+	CommitPoint *CommitPoint
+
+	// Decision is the decision awaited during the resolution of a ministry, if any.
+	Decision *ResolutionDecision
+
+	Variants GameVariantIDS `form:"variants"`
+	Setup    SetupOptions
+
+	// Neutral is the non-player family used by the two player and solo rules.
+	Neutral *Player
+
+	CourtDeck    CourtCards
+	CourtDiscard CourtCards
+	SoloTarget   int `form:"solo-target"`
+
+	// TournamentID identifies the tournament of which the game is a part, if any.
+	TournamentID int64
+
+	// BasicGame and AdmiralVariant are retained to load games saved before Variants.
 	BasicGame      bool `form:"basic-game"`
 	AdmiralVariant bool `form:"admiral-variant"`
+
+	// ShuffledDecks is set once the decks are shuffled, as the decks of games saved before draws
+	// were made from the top of the deck are in order.
+	ShuffledDecks bool
 }
 
 func (g *Game) ChiefMinister() *Player {
@@ -165,7 +192,9 @@ func (g *Game) Colors() color.Colors {
 
 func (g *Game) Start(c *gin.Context) error {
 	g.Status = game.Running
-	g.Phase = Setup
+	if err := g.setPhase(Setup); err != nil {
+		return err
+	}
 	g.Junks = 25
 
 	g.ChiefMinisterID = NoPlayerID
@@ -180,6 +209,7 @@ func (g *Game) Start(c *gin.Context) error {
 	g.OfficialsDeck = NewOfficialsDeck()
 	g.ConDeck = NewConDeck(g.NumPlayers)
 	g.EmperorDeck = NewEmperorDeck()
+	g.ShuffledDecks = true
 	g.ActionSpaces = ActionSpaces{
 		BribeSecureSpace:    &ActionSpace{ID: BribeSecureSpace, Cubes: Cubes{}},
 		NominateSpace:       &ActionSpace{ID: NominateSpace, Cubes: Cubes{}},
@@ -199,8 +229,8 @@ func (g *Game) Start(c *gin.Context) error {
 	g.CreateDistantLands()
 	g.CreateForeignLands()
 	g.CreateCandidates()
-	g.start()
-	return nil
+	g.variantStart()
+	return g.start()
 }
 
 func (g *Game) addNewPlayer() {
@@ -212,11 +242,16 @@ func (g *Game) ColorMap() color.Colors {
 	return color.Colors{color.Yellow, color.Purple, color.Green, color.White, color.Black}
 }
 
-func (g *Game) start() {
-	g.Phase = StartGame
+func (g *Game) start() error {
+	if err := g.setPhase(StartGame); err != nil {
+		return err
+	}
 	g.Round = 1
-	g.countGiftsPhase()
-	g.chooseChiefMinisterPhase()
+	g.variantNewRound()
+	if err := g.countGiftsPhase(); err != nil {
+		return err
+	}
+	return g.chooseChiefMinisterPhase()
 }
 
 func (g *Game) Players() Players {
@@ -241,11 +276,11 @@ func (g *Game) setPlayers(players Players) {
 	}
 }
 
-func (g *Game) actionsPhase() {
+func (g *Game) actionsPhase() error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = Actions
+	return g.setPhase(Actions)
 }
 
 func (g *Game) inActionsOrImperialFavourPhase() bool {
@@ -256,9 +291,9 @@ func (g *Game) resetTurn(c *gin.Context, cu *user.User) (string, game.ActionType
 	cp := g.CurrentPlayer()
 
 	if !g.IsCurrentPlayer(cu) {
-		return "", game.None, sn.NewVError("Only the current player may perform this action.")
+		return "", game.None, g.vError("error.only-current-player-may-perform-action")
 	}
-	restful.AddNoticef(c, "%s reset turn.", g.NameFor(cp))
+	restful.AddNoticef(c, "%s", g.Locale().Sprintf("notice.reset-turn", g.NameFor(cp)))
 	return "", game.Reset, nil
 }
 
@@ -278,10 +313,11 @@ func (g *Game) PlayerByID(id int) *Player {
 }
 
 func (g *Game) PlayerBySID(sid string) *Player {
-	if p := g.Header.PlayerBySID(sid); p != nil {
-		return p.(*Player)
+	id, err := strconv.Atoi(sid)
+	if err != nil {
+		return nil
 	}
-	return nil
+	return g.PlayerByID(id)
 }
 
 func (g *Game) PlayerByUserID(id int64) *Player {
@@ -299,10 +335,11 @@ func (g *Game) PlayerByIndex(index int) *Player {
 	return nil
 }
 
+// DrawConCard draws the top card of the Confucius deck, first shuffling the discard pile to form a
+// new deck if the deck is empty.  It returns nil if both are empty.
 func (g *Game) DrawConCard() *ConCard {
-	if len(g.ConDeck) == 0 {
-		g.ConDeck = g.ConDiscardPile
-		g.ConDiscardPile = ConCards{}
+	if len(g.ConDeck) == 0 && len(g.ConDiscardPile) > 0 {
+		g.reshuffleConDeck()
 	}
 	return g.ConDeck.Draw()
 }
@@ -330,15 +367,3 @@ func (g *Game) OnVoyage(cu *user.User) JunkVoyages {
 	}
 	return jv
 }
-
-func (g *Game) options() string {
-	s := "Advanced"
-	if g.BasicGame {
-		s = "Basic"
-	}
-
-	if g.AdmiralVariant {
-		return s + " with Admiral Variant"
-	}
-	return s + " without Admiral Variant"
-}
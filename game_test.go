@@ -0,0 +1,91 @@
+package confucius
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// newTestGame starts a game of n players with the variants, as accepting its last player would,
+// and links its parts to the game as loading it would.
+func newTestGame(t *testing.T, n int, vs ...GameVariantID) (*gin.Context, *Game) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	g := New(c, 1)
+	g.Title = "Test"
+	g.NumPlayers = n
+	for i := 1; i <= n; i++ {
+		u := user.New(int64(i))
+		u.Name = fmt.Sprintf("player%d", i)
+		g.AddUser(u)
+		g.Users = append(g.Users, u)
+	}
+	g.setVariants(vs)
+
+	if err := g.Start(c); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	linkTestGame(g)
+	return c, g
+}
+
+func linkTestGame(g *Game) {
+	for _, p := range g.Players() {
+		p.init(g)
+	}
+	if g.Neutral != nil {
+		g.Neutral.init(g)
+	}
+	for _, m := range g.Ministries {
+		m.init(g)
+	}
+	for _, can := range g.Candidates {
+		can.game = g
+	}
+	for _, land := range g.ForeignLands {
+		land.init(g)
+	}
+	for _, land := range g.DistantLands {
+		land.init(g)
+	}
+}
+
+// setOfficials replaces the officials of the ministry with unsecured officials of the costs, held
+// by the players with the ids, by seniority.  Officials absent from holders are unbribed.
+func setOfficials(m *Ministry, costs map[Seniority]int, holders map[Seniority]int) {
+	m.Officials = make(OfficialTiles, len(costs))
+	for seniority, cost := range costs {
+		o := newOfficialTile()
+		o.Seniority = seniority
+		o.Cost = cost
+		if id, ok := holders[seniority]; ok {
+			o.PlayerID = id
+		}
+		m.Officials[seniority] = o
+	}
+	m.init(m.Game())
+}
+
+// holdersOf returns the id of the player holding each official of the ministry, by seniority.
+func holdersOf(m *Ministry) map[Seniority]int {
+	hs := make(map[Seniority]int)
+	for seniority, o := range m.Officials {
+		if o.PlayerID != NoPlayerID {
+			hs[seniority] = o.PlayerID
+		}
+	}
+	return hs
+}
+
+// postForm makes the request of the context a post of the form, as taking an action does.
+func postForm(c *gin.Context, form url.Values) {
+	c.Request = httptest.NewRequest("POST", "/game/show/1", strings.NewReader(form.Encode()))
+	c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+}
@@ -0,0 +1,231 @@
+package confucius
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// Number of cards a player must play to tutor a student in order to cancel a gift obligation.
+const tutorCancelCards = 3
+
+// GiftGraphNode is a player of the gift obligation graph.
+type GiftGraphNode struct {
+	PlayerID int
+	Name     string
+	Color    string
+}
+
+// GiftObligation is an edge of the gift obligation graph: the player identified by FromID owes
+// the player identified by ToID for a gift.  The Cancel fields report the ways in which the
+// debtor could presently cancel the obligation.
+type GiftObligation struct {
+	FromID            int
+	ToID              int
+	Gift              string
+	Value             int
+	CancelByTutor     bool
+	CancelByTransfer  bool
+	CancelByTemp      bool
+	CancelByGift      bool
+	CancellationNotes []string
+}
+
+// GiftGraph provides the gift obligations between players.
+type GiftGraph struct {
+	Nodes []*GiftGraphNode
+	Edges []*GiftObligation
+}
+
+// GiftGraph returns the gift obligations of the game as a graph.  The colors of the nodes are those
+// seen by the given user.
+func (g *Game) GiftGraph(cu *user.User) *GiftGraph {
+	graph := new(GiftGraph)
+	for _, p := range g.Players() {
+		graph.Nodes = append(graph.Nodes, &GiftGraphNode{
+			PlayerID: p.ID(),
+			Name:     g.NameFor(p),
+			Color:    g.Color(p, cu).String(),
+		})
+	}
+
+	for _, debtor := range g.Players() {
+		for _, gift := range debtor.GiftsReceived {
+			giver := gift.Player()
+			if giver == nil {
+				continue
+			}
+			graph.Edges = append(graph.Edges, debtor.obligationTo(giver, gift))
+		}
+	}
+	return graph
+}
+
+// obligationTo returns the obligation of the player to the giver of the gift.
+func (p *Player) obligationTo(giver *Player, gift *GiftCard) *GiftObligation {
+	l := p.Game().Locale()
+	o := &GiftObligation{
+		FromID: p.ID(),
+		ToID:   giver.ID(),
+		Gift:   gift.Name(),
+		Value:  gift.Value.Int(),
+	}
+
+	if p.canCancelByTutoring(giver) {
+		o.CancelByTutor = true
+		o.CancellationNotes = append(o.CancellationNotes,
+			l.Sprintf("gift.cancel-by-tutor", giver.Name(), tutorCancelCards))
+	}
+
+	if p.hasInfluenceToTransfer() {
+		o.CancelByTransfer = true
+		o.CancellationNotes = append(o.CancellationNotes,
+			l.Sprintf("gift.cancel-by-transfer", giver.Name()))
+	}
+
+	if p.Game().ministryInProgress() != nil && p.TempPlayers().Include(giver) {
+		o.CancelByTemp = true
+		o.CancellationNotes = append(o.CancellationNotes,
+			l.Sprintf("gift.cancel-by-temp", giver.Name()))
+	}
+
+	if p.hasGiftBoughtWorthMoreThan(gift.Value) {
+		o.CancelByGift = true
+		o.CancellationNotes = append(o.CancellationNotes,
+			l.Sprintf("gift.cancel-by-gift", giver.Name(), l.Name(gift.Name())))
+	}
+	return o
+}
+
+// canCancelByTutoring returns true if the giver has a student nominated for the examination and
+// the player holds enough cards to cancel the obligation by tutoring it.
+func (p *Player) canCancelByTutoring(giver *Player) bool {
+	can := p.Game().Candidate()
+	if can == nil || p.Equal(giver) || len(p.ConCardHand) < tutorCancelCards {
+		return false
+	}
+	return giver.Equal(can.Player()) || giver.Equal(can.OtherPlayer())
+}
+
+func (p *Player) hasGiftBoughtWorthMoreThan(value GiftCardValue) bool {
+	for _, gift := range p.GiftsBought {
+		if gift.Value > value {
+			return true
+		}
+	}
+	return false
+}
+
+// Dimensions of the rendered gift obligation graph.
+const (
+	giftGraphSize   = 400
+	giftGraphRadius = 150
+	giftNodeRadius  = 28
+)
+
+// SVG renders the graph with players placed on a circle and an arrow from each debtor to the
+// player owed.
+func (graph *GiftGraph) SVG() template.HTML {
+	center := float64(giftGraphSize) / 2
+	points := make(map[int][2]float64, len(graph.Nodes))
+	for i, n := range graph.Nodes {
+		angle := 2*math.Pi*float64(i)/float64(len(graph.Nodes)) - math.Pi/2
+		points[n.PlayerID] = [2]float64{
+			center + giftGraphRadius*math.Cos(angle),
+			center + giftGraphRadius*math.Sin(angle),
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		giftGraphSize, giftGraphSize, giftGraphSize, giftGraphSize)
+	b.WriteString(`<defs><marker id="gift-arrow" markerWidth="10" markerHeight="10" refX="9" refY="3" orient="auto">`)
+	b.WriteString(`<path d="M0,0 L0,6 L9,3 z" fill="#333" /></marker></defs>`)
+
+	for _, e := range graph.Edges {
+		from, ok1 := points[e.FromID]
+		to, ok2 := points[e.ToID]
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		// Shorten the arrow so it ends at the edge of the node.
+		dx, dy := to[0]-from[0], to[1]-from[1]
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		ux, uy := dx/length, dy/length
+		x1, y1 := from[0]+ux*giftNodeRadius, from[1]+uy*giftNodeRadius
+		x2, y2 := to[0]-ux*giftNodeRadius, to[1]-uy*giftNodeRadius
+
+		// Offset the edge, so obligations in each direction do not overlap.
+		x1, y1, x2, y2 = x1-uy*6, y1+ux*6, x2-uy*6, y2+ux*6
+
+		dash := ""
+		if e.CancelByTutor || e.CancelByTransfer || e.CancelByTemp || e.CancelByGift {
+			dash = ` stroke-dasharray="6,3"`
+		}
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#333" stroke-width="%d"%s marker-end="url(#gift-arrow)" />`,
+			x1, y1, x2, y2, e.Value, dash)
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="11" text-anchor="middle">%s (%d)</text>`,
+			(x1+x2)/2-uy*8, (y1+y2)/2+ux*8, template.HTMLEscapeString(e.Gift), e.Value)
+	}
+
+	for _, n := range graph.Nodes {
+		p := points[n.PlayerID]
+		fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="%d" fill="%s" stroke="#333" stroke-width="2" />`,
+			p[0], p[1], giftNodeRadius, n.Color)
+		fmt.Fprintf(&b, `<text x="%.1f" y="%.1f" font-size="12" text-anchor="middle" stroke="white" stroke-width="3" paint-order="stroke">%s</text>`,
+			p[0], p[1]+4, template.HTMLEscapeString(n.Name))
+	}
+
+	b.WriteString(`</svg>`)
+	return template.HTML(b.String())
+}
+
+// GiftGraphSVG renders the gift obligation graph for display alongside the gift tracker.
+func (g *Game) GiftGraphSVG(cu *user.User) template.HTML {
+	return g.GiftGraph(cu).SVG()
+}
+
+func (client *Client) giftGraphJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	c.JSON(http.StatusOK, g.GiftGraph(cu))
+}
+
+func (client *Client) giftGraphSVG(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	c.Data(http.StatusOK, "image/svg+xml", []byte(g.GiftGraph(cu).SVG()))
+}
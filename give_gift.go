@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -38,6 +37,7 @@ func (g *Game) giveGift(c *gin.Context, cu *user.User) (string, game.ActionType,
 
 	// Create Action Object for logging
 	entry := cp.newGiveGiftEntry(recipient, gift, canceledGift)
+	g.emit(&GiftGiven{PlayerID: cp.ID(), RecipientID: recipient.ID(), Value: gift.Value, Canceled: canceledGift})
 
 	// Set flash message
 	restful.AddNoticef(c, string(entry.HTML()))
@@ -63,12 +63,21 @@ func (p *Player) newGiveGiftEntry(op *Player, gift *GiftCard, canceled bool) *gi
 }
 
 func (e *giveGiftEntry) HTML() template.HTML {
+	l := e.locale()
 	if !e.CanceledGift {
-		return restful.HTML("%s gave value %d gift (%s) to %s.",
-			e.Player().Name(), e.Gift.Value, e.Gift.Name(), e.OtherPlayer().Name())
+		return l.HTML("entry.give-gift",
+			e.Player().Name(), e.Gift.Value, l.Name(e.Gift.Name()), e.OtherPlayer().Name())
 	}
-	return restful.HTML("%s gave value %d gift (%s) to %s and canceled gift from %s.",
-		e.Player().Name(), e.Gift.Value, e.Gift.Name(), e.OtherPlayer().Name(), e.OtherPlayer().Name())
+	return l.HTML("entry.give-gift-cancel",
+		e.Player().Name(), e.Gift.Value, l.Name(e.Gift.Name()), e.OtherPlayer().Name(), e.OtherPlayer().Name())
+}
+
+func (e *giveGiftEntry) obligationChanges() []*obligationChange {
+	changes := []*obligationChange{{debtorID: e.OtherPlayerID, creditorID: e.PlayerID, gift: e.Gift.Name()}}
+	if e.CanceledGift {
+		changes = append(changes, &obligationChange{debtorID: e.PlayerID, creditorID: e.OtherPlayerID, canceled: true})
+	}
+	return changes
 }
 
 func (g *Game) validateGiveGift(c *gin.Context, cu *user.User) (*Player, *GiftCard, int, error) {
@@ -97,21 +106,30 @@ func (g *Game) validateGiveGift(c *gin.Context, cu *user.User) (*Player, *GiftCa
 
 	switch {
 	case recipient == nil:
-		return nil, nil, 0, sn.NewVError("Recipient not found.")
+		return nil, nil, 0, g.vError("error.recipient-not-found")
 	case givenGift == nil:
-		return nil, nil, 0, sn.NewVError("You don't have a gift of value %d to give.", giftValue)
+		return nil, nil, 0, g.vError("error.dont-gift-value-give", giftValue)
 	case oldGift != nil && oldGift.Value > givenGift.Value:
-		return nil, nil, 0, sn.NewVError("You must give a gift that is greater than your present gift to the player.")
+		return nil, nil, 0, g.vError("error.must-give-gift-greater-than-present")
 	case cp.Equal(recipient):
-		return nil, nil, 0, sn.NewVError("You can't give yourself a gift.")
+		return nil, nil, 0, g.vError("error.cant-give-yourself-gift")
 	case receivedGift != nil && receivedGift.Value > givenGift.Value:
-		return nil, nil, 0, sn.NewVError("You must give a gift that is greater than or equal to the gift the player gave you.")
+		return nil, nil, 0, g.vError("error.must-give-gift-greater-than-equal")
 	}
 	return recipient, givenGift, cubes, nil
 }
 
 func (g *Game) EnableGiveGift(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	requiredCubes := cp.RequiredCubesFor(GiveGiftSpace)
-	return g.IsCurrentPlayer(cu) && cp.ActionCubes >= requiredCubes && len(cp.GiftsBought) >= 1
+	return g.GiveGiftReasons(cu).None()
+}
+
+// GiveGiftReasons returns why the user cu may not give a gift.
+func (g *Game) GiveGiftReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.cubes(cp, GiveGiftSpace).
+		require(len(cp.GiftsBought) >= 1, "reason.no-bought-gifts")
+	return r.reasons
 }
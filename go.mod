@@ -19,4 +19,5 @@ require (
 	github.com/gin-contrib/sessions v0.0.3
 	github.com/gin-gonic/gin v1.6.3
 	github.com/mailjet/mailjet-apiv3-go v0.0.0-20201009050126-c24bc15a9394
+	golang.org/x/image v0.0.0-20210220032944-ac19c3e999fb
 )
@@ -0,0 +1,283 @@
+package confucius
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/sn"
+	"github.com/gin-gonic/gin"
+)
+
+// Locale identifies the language in which log entries, notices and errors are rendered.
+type Locale string
+
+const (
+	English Locale = "en"
+	French  Locale = "fr"
+)
+
+const (
+	localeCookie         = "confucius-locale"
+	localeKey            = "locale"
+	localePreferenceKind = "LocalePreference"
+)
+
+var locales = []Locale{English, French}
+
+// catalogs provides the messages of each locale keyed by message id.  Messages are fmt formats and
+// may use explicit argument indexes (e.g., %[2]d), where a language orders arguments differently.
+// Messages missing from a catalog fall back to English.
+var catalogs = map[Locale]map[string]string{
+	English: englishCatalog,
+	French:  frenchCatalog,
+}
+
+func toLocale(s string) (Locale, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(s, "-_"); i != -1 {
+		s = s[:i]
+	}
+	for _, l := range locales {
+		if string(l) == s {
+			return l, true
+		}
+	}
+	return English, false
+}
+
+func (l Locale) message(id string) string {
+	if msg, ok := catalogs[l][id]; ok {
+		return msg
+	}
+	if msg, ok := englishCatalog[id]; ok {
+		return msg
+	}
+	return id
+}
+
+// Sprintf formats the message identified by id.
+func (l Locale) Sprintf(id string, args ...interface{}) string {
+	return fmt.Sprintf(l.message(id), args...)
+}
+
+// HTML formats the message identified by id as restful.HTML does.
+func (l Locale) HTML(id string, args ...interface{}) template.HTML {
+	return template.HTML(l.Sprintf(id, args...))
+}
+
+// VError returns a validation error reporting the message identified by id.
+func (l Locale) VError(id string, args ...interface{}) *sn.VError {
+	return sn.NewVError("%s", l.Sprintf(id, args...))
+}
+
+// Name translates the name of a ministry, gift or land.  Names are stored in English by log
+// entries, so unknown names are returned unchanged.
+func (l Locale) Name(name string) string {
+	if msg, ok := catalogs[l]["name."+name]; ok {
+		return msg
+	}
+	return name
+}
+
+// Plural returns the singular or plural form of the word identified by id, as appropriate for n.
+func (l Locale) Plural(id string, n int) string {
+	one := n == 1
+	if l == French {
+		one = n == 0 || n == 1
+	}
+	if one {
+		return l.message(id + ".one")
+	}
+	return l.message(id + ".other")
+}
+
+// ToSentence joins the strings as restful.ToSentence does, using the conjunction of the locale.
+func (l Locale) ToSentence(ss []string) string {
+	and := l.message("and")
+	switch length := len(ss); length {
+	case 0:
+		return ""
+	case 1:
+		return ss[0]
+	case 2:
+		return ss[0] + " " + and + " " + ss[1]
+	default:
+		return strings.Join(ss[:length-1], ", ") + l.message("serial-comma") + " " + and + " " + ss[length-1]
+	}
+}
+
+// localeFrom returns the locale preferred by the user making the request: the locale stored for the
+// current user, if any, then the locale selected in the browser, and otherwise the locale best
+// matching the Accept-Language header.
+func localeFrom(c *gin.Context) Locale {
+	if c == nil || c.Request == nil {
+		return English
+	}
+
+	if l, ok := c.Value(localeKey).(Locale); ok {
+		return l
+	}
+
+	if s, err := c.Cookie(localeCookie); err == nil {
+		if l, ok := toLocale(s); ok {
+			return l
+		}
+	}
+	return acceptedLocale(c.GetHeader("Accept-Language"))
+}
+
+// acceptedLocale returns the supported locale of highest quality in an Accept-Language header.
+func acceptedLocale(header string) Locale {
+	type accepted struct {
+		locale  Locale
+		quality float64
+	}
+
+	var as []accepted
+	for _, s := range strings.Split(header, ",") {
+		ss := strings.Split(s, ";")
+		l, ok := toLocale(ss[0])
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range ss[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			as = append(as, accepted{locale: l, quality: q})
+		}
+	}
+
+	if len(as) == 0 {
+		return English
+	}
+	sort.SliceStable(as, func(i, j int) bool { return as[i].quality > as[j].quality })
+	return as[0].locale
+}
+
+// Locale returns the locale in which the game is rendered for the user making the request.
+func (g *Game) Locale() Locale {
+	if g.locale == "" {
+		return English
+	}
+	return g.locale
+}
+
+// Locales returns the supported locales for selection by the user.
+func (g *Game) Locales() []Locale {
+	return locales
+}
+
+func (g *Game) vError(id string, args ...interface{}) *sn.VError {
+	return g.Locale().VError(id, args...)
+}
+
+// locale returns the locale of the game rendering the entry.
+func (e *Entry) locale() Locale {
+	if g, ok := e.Game().(*Game); ok && g != nil {
+		return g.Locale()
+	}
+	return English
+}
+
+// LocalePreference records the locale selected by a user, so that the selection follows the user
+// across browsers.
+type LocalePreference struct {
+	Key       *datastore.Key `datastore:"__key__"`
+	Locale    Locale
+	UpdatedAt time.Time
+}
+
+func newLocalePreferenceKey(c *gin.Context, uid int64) *datastore.Key {
+	return datastore.IDKey(localePreferenceKind, uid, pk(c))
+}
+
+// localePreference returns the locale stored for the user, if the user has selected one.
+func (client *Client) localePreference(c *gin.Context, uid int64) (Locale, bool) {
+	k := newLocalePreferenceKey(c, uid)
+	if item, found := client.Cache.Get(k.Encode()); found {
+		if l, ok := item.(Locale); ok {
+			return toLocale(string(l))
+		}
+	}
+
+	pref := &LocalePreference{Key: k}
+	err := client.DS.Get(c, k, pref)
+	if err != nil && err != datastore.ErrNoSuchEntity {
+		client.Log.Warningf(err.Error())
+		return English, false
+	}
+
+	// Users without a stored locale are cached too, so that their requests do not query the datastore.
+	client.Cache.SetDefault(k.Encode(), pref.Locale)
+	return toLocale(string(pref.Locale))
+}
+
+// userLocale makes the locale stored for the current user, if any, the locale of the request.
+func (client *Client) userLocale(c *gin.Context) {
+	cu, err := client.User.Current(c)
+	if err != nil || cu == nil {
+		return
+	}
+
+	if l, ok := client.localePreference(c, cu.ID()); ok {
+		c.Set(localeKey, l)
+	}
+}
+
+// setLocale records the locale selected by the user and returns the user to the referring page.
+// The locale is stored for a logged in user, and otherwise only in the browser.
+func (client *Client) setLocale(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	if l, ok := toLocale(c.PostForm("locale")); ok {
+		c.SetCookie(localeCookie, string(l), 365*24*60*60, "/", "", false, true)
+
+		cu, err := client.User.Current(c)
+		if err == nil && cu != nil {
+			pref := &LocalePreference{Key: newLocalePreferenceKey(c, cu.ID()), Locale: l, UpdatedAt: time.Now()}
+			_, err = client.DS.Put(c, pref.Key, pref)
+			if err != nil {
+				client.Log.Errorf(err.Error())
+			} else {
+				client.Cache.SetDefault(pref.Key.Encode(), l)
+			}
+		}
+	}
+
+	c.Redirect(http.StatusSeeOther, localPath(c, c.Request.Referer()))
+}
+
+// localPath returns the path and query of the referring page, provided it is a page of this site,
+// and otherwise the home page, so that the locale form cannot redirect elsewhere.
+func localPath(c *gin.Context, referer string) string {
+	u, err := url.Parse(referer)
+	switch {
+	case err != nil, referer == "":
+		return homePath
+	case u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https":
+		return homePath
+	case u.Host != "" && u.Host != c.Request.Host:
+		return homePath
+	case u.Host == "" && u.Scheme != "":
+		return homePath
+	case !strings.HasPrefix(u.Path, "/") || strings.HasPrefix(u.Path, "//"):
+		return homePath
+	}
+	return u.RequestURI()
+}
@@ -0,0 +1,66 @@
+package confucius
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLocalPath(t *testing.T) {
+	tests := []struct {
+		referer string
+		want    string
+	}{
+		{"", homePath},
+		{"/confucius/game/show/7?tab=log", "/confucius/game/show/7?tab=log"},
+		{"http://www.example.com/confucius/game/show/7", "/confucius/game/show/7"},
+		{"https://evil.example.net/phish", homePath},
+		{"//evil.example.net/phish", homePath},
+		{"javascript:alert(1)", homePath},
+		{"confucius/game/show/7", homePath},
+	}
+
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "http://www.example.com/locale", nil)
+	for _, tt := range tests {
+		if got := localPath(c, tt.referer); got != tt.want {
+			t.Errorf("localPath(%q): got %q, want %q", tt.referer, got, tt.want)
+		}
+	}
+}
+
+func TestPhaseNameLocalised(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	g.Phase = ImperialFavour
+	g.locale = French
+
+	if got, want := g.PhaseName(), "Faveur impériale"; got != want {
+		t.Errorf("phase name: got %q, want %q", got, want)
+	}
+	if text := g.RenderText(nil); !strings.Contains(text, "phase : Faveur impériale") {
+		t.Errorf("text board does not name the phase in French:\n%s", text)
+	}
+}
+
+func TestOptionsLocalised(t *testing.T) {
+	_, g := newTestGame(t, 3, AdmiralVariant)
+	if got, want := g.options(), "Advanced with Admiral Variant"; got != want {
+		t.Errorf("English options: got %q, want %q", got, want)
+	}
+
+	g.locale = French
+	if got, want := g.options(), "Avancé avec Variante de l'amiral"; got != want {
+		t.Errorf("French options: got %q, want %q", got, want)
+	}
+}
+
+func TestFrenchPhaseNames(t *testing.T) {
+	for _, phase := range flowOrder() {
+		if name := PhaseNames[phase]; French.Name(name) == name && name != "Actions" && name != "Invasion" {
+			t.Errorf("%s has no French name", name)
+		}
+	}
+}
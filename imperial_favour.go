@@ -4,11 +4,13 @@ import (
 	"github.com/SlothNinja/log"
 )
 
-func (g *Game) imperialFavourPhase() {
+func (g *Game) imperialFavourPhase() error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = ImperialFavour
+	if err := g.setPhase(ImperialFavour); err != nil {
+		return err
+	}
 	g.ChiefMinister().ActionCubes += 1
 	g.ChiefMinister().clearActions()
 	g.SetCurrentPlayerers(g.ChiefMinister())
@@ -16,4 +18,5 @@ func (g *Game) imperialFavourPhase() {
 	for _, p := range g.Players() {
 		p.Passed = false
 	}
+	return nil
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -27,25 +26,31 @@ func (g *Game) invadeLand(c *gin.Context, cu *user.User) (string, game.ActionTyp
 	}
 
 	cp := g.CurrentPlayer()
-	cp.PerformedAction = true
 
 	// Place Action Cubes
 	cp.PlaceCubesIn(RecruitArmySpace, cubes)
 
+	entry := cp.invadeLand(box, cards)
+
+	// Set flash message
+	restful.AddNoticef(c, string(entry.HTML()))
+	return "", game.Cache, nil
+}
+
+func (p *Player) invadeLand(box *ForeignLandBox, cards ConCards) *invadeLandEntry {
+	g := p.Game()
+	p.PerformedAction = true
+
 	// Commit Recruited Army
-	cp.RecruitedArmies -= 1
-	box.setPlayer(cp)
+	p.RecruitedArmies -= 1
+	box.setPlayer(p)
 
 	// Move played cards from hand to discard pile
-	cp.ConCardHand.Remove(cards...)
+	p.ConCardHand.Remove(cards...)
 	g.ConDiscardPile.Append(cards...)
 
 	// Create Action Object for logging
-	entry := cp.newInvadeLandEntry(cards, box)
-
-	// Set flash message
-	restful.AddNoticef(c, string(entry.HTML()))
-	return "", game.Cache, nil
+	return p.newInvadeLandEntry(cards, box)
 }
 
 type invadeLandEntry struct {
@@ -68,8 +73,12 @@ func (p *Player) newInvadeLandEntry(cards ConCards, box *ForeignLandBox) *invade
 }
 
 func (e *invadeLandEntry) HTML() template.HTML {
-	return restful.HTML("%s spent %d Confucius cards having a value of %d coins to invade the %d VP box of %s.",
-		e.Player().Name(), len(e.Played), e.Played.Coins(), e.Points, e.ForeignLandName)
+	l := e.locale()
+	if len(e.Played) == 0 {
+		return l.HTML("entry.invade-land", e.Player().Name(), e.Points, l.Name(e.ForeignLandName))
+	}
+	return l.HTML("entry.invade-land-paid",
+		e.Player().Name(), len(e.Played), e.Played.Coins(), e.Points, l.Name(e.ForeignLandName))
 }
 
 func (g *Game) validateInvadeLand(c *gin.Context, cu *user.User) (*ForeignLandBox, ConCards, int, error) {
@@ -81,48 +90,59 @@ func (g *Game) validateInvadeLand(c *gin.Context, cu *user.User) (*ForeignLandBo
 		return nil, nil, 0, err
 	}
 
-	cards, err := g.getConCards(c, "invade-land")
+	box, err := g.getForeignLandBox(c, "invade-land")
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
-	box, err := g.getForeignLandBox(c, "invade-land")
+	land := box.land
+	cost := land.Cost()
+	cards, err := g.getPayment(c, "invade-land", cost, payCoins)
 	if err != nil {
 		return nil, nil, 0, err
 	}
-
 	coinValue := cards.Coins()
-	land := box.land
-	cost := land.Cost()
 	cp := g.CurrentPlayer()
 
 	switch {
 	case coinValue < cost:
-		return nil, nil, 0, sn.NewVError("You selected cards having %d total coins, but you need %d coins to invade the selected land.", coinValue, cost)
+		return nil, nil, 0, g.vError("error.insufficient-coins-invade", coinValue, cost)
 	case !cp.hasRecruitedArmies():
-		return nil, nil, 0, sn.NewVError("You have no recruited armies for an invasion.")
+		return nil, nil, 0, g.vError("error.no-recruited-armies-invasion")
 	}
 
 	return box, cards, cubes, nil
 }
 
 func (g *Game) EnableInvadeLand(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.inActionsOrImperialFavourPhase() && g.CurrentPlayer() != nil &&
-		!cp.PerformedAction && g.IsCurrentPlayer(cu) &&
-		cp.hasEnoughCubesFor(RecruitArmySpace) && cp.hasRecruitedArmies() && cp.canAffordInvasion()
+	return g.InvadeLandReasons(cu).None()
 }
 
-func (p *Player) canAffordInvasion() bool {
-	g := p.Game()
-	coins := p.ConCardHand.Coins()
+// InvadeLandReasons returns why the user cu may not invade a foreign land.
+func (g *Game) InvadeLandReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, RecruitArmySpace).
+		require(cp.hasRecruitedArmies(), "reason.no-recruited-armies")
+	if cost, ok := g.cheapestForeignLand(); ok {
+		r.coins(cp, cost)
+	} else {
+		r.require(false, "reason.no-foreign-land")
+	}
+	return r.reasons
+}
 
+// cheapestForeignLand returns the least cost of invading a foreign land, reporting whether there is one.
+func (g *Game) cheapestForeignLand() (int, bool) {
+	cost, found := 0, false
 	for _, land := range g.ForeignLands {
-		if coins >= land.Cost() {
-			return true
+		if !found || land.Cost() < cost {
+			cost, found = land.Cost(), true
 		}
 	}
-	return false
+	return cost, found
 }
 
 func (p *Player) hasRecruitedArmies() bool {
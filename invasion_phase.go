@@ -2,7 +2,6 @@ package confucius
 
 import (
 	"encoding/gob"
-	"fmt"
 	"html/template"
 
 	"github.com/SlothNinja/log"
@@ -14,30 +13,50 @@ func init() {
 	gob.RegisterName("*game.invasionEntry", new(invasionEntry))
 }
 
-func (g *Game) invasionPhase(c *gin.Context) {
+func (g *Game) invasionPhase(c *gin.Context) error {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = Invasion
+	if err := g.setPhase(Invasion); err != nil {
+		return err
+	}
 	for i, land := range g.ForeignLands {
+		var entry *invasionEntry
 		switch {
 		case land.Resolved:
 		case land.AllBoxesOccupied():
-			g.successfulInvasionOf(i)
+			entry = g.successfulInvasionOf(i)
 		case g.Wall >= 4 && i == 0, g.Wall >= 6 && i == 1, g.Wall >= 8 && i == 2:
-			g.unsuccessfulInvasionOf(i)
+			entry = g.unsuccessfulInvasionOf(i)
+		}
+
+		if entry != nil {
+			g.emit(&InvasionResolved{ForeignLand: land.ID, Successful: entry.Successful, PlayerIDS: land.playerIDS()})
 		}
 	}
+	return nil
 }
 
-func (g *Game) successfulInvasionOf(landIndex int) {
+// playerIDS returns the ids of the players having armies in the land.
+func (land *ForeignLand) playerIDS() []int {
+	var ids []int
+	for _, box := range land.Boxes {
+		if box.Invaded() && !includeID(ids, box.PlayerID) {
+			ids = append(ids, box.PlayerID)
+		}
+	}
+	return ids
+}
+
+func (g *Game) successfulInvasionOf(landIndex int) *invasionEntry {
 	land := g.ForeignLands[landIndex]
-	entry := g.unsuccessfulInvasionOf(landIndex)
 	for _, box := range land.Boxes {
 		p := box.Player()
-		p.Score += box.Points
+		p.addScore(InvasionScore, box.Points)
 	}
+	entry := g.unsuccessfulInvasionOf(landIndex)
 	entry.Successful = true
+	return entry
 }
 
 func (g *Game) unsuccessfulInvasionOf(landIndex int) *invasionEntry {
@@ -73,24 +92,25 @@ func (g *Game) newInvasionEntry() *invasionEntry {
 
 func (e *invasionEntry) HTML() template.HTML {
 	g := e.Game().(*Game)
+	l := e.locale()
 	var s string
 	if e.Successful {
-		s = fmt.Sprintf("<div>The invasion of %s succeeded.</div>", e.ForeignLand.Name())
+		s = "<div>" + l.Sprintf("entry.invasion-succeeded", l.Name(e.ForeignLand.Name())) + "</div>"
 		for _, box := range e.ForeignLand.Boxes {
 			player := g.PlayerByID(box.PlayerID)
-			s += fmt.Sprintf("<div>%s received %d points.</div>", g.NameFor(player), box.Points)
+			s += "<div>" + l.Sprintf("entry.invasion-points", g.NameFor(player), box.Points) + "</div>"
 			if e.AwardCard && box.AwardCard {
-				s += fmt.Sprintf("<div>%s awarded an Emperor's Reward card.</div>", g.NameFor(player))
+				s += "<div>" + l.Sprintf("entry.invasion-reward-card", g.NameFor(player)) + "</div>"
 			}
 		}
 		return restful.HTML(s)
 	}
-	s = fmt.Sprintf("<div>The invasion of %s failed.</div>", e.ForeignLand.Name())
+	s = "<div>" + l.Sprintf("entry.invasion-failed", l.Name(e.ForeignLand.Name())) + "</div>"
 	if e.AwardCard {
 		for _, box := range e.ForeignLand.Boxes {
 			if box.AwardCard {
 				player := e.Game().(*Game).PlayerByID(box.PlayerID)
-				s += fmt.Sprintf("<div>%s awarded an Emperor's Reward card.</div>", g.NameFor(player))
+				s += "<div>" + l.Sprintf("entry.invasion-reward-card", g.NameFor(player)) + "</div>"
 			}
 		}
 	}
@@ -0,0 +1,294 @@
+package confucius
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// Kinds of the form values submitted with actions.
+const (
+	cardsField    = "cards"    // Confucius cards, submitted as <name>-coins1, <name>-coins2 and <name>-coins3 counts
+	officialField = "official" // An official, as <ministry>-<seniority>
+	playerField   = "player"   // A player id
+	boxField      = "box"      // A foreign land box, as <land index>-<box index>
+	landField     = "land"     // A distant land id
+	giftField     = "gift"     // A gift card value
+	numberField   = "number"   // A count, such as of junks
+	fixedField    = "fixed"    // A value determined by the action, such as the Emperor's Reward card played
+)
+
+// ActionField describes a form value submitted with an action.  Options, where provided, lists the
+// values the field may take.  AutoPay reports whether cards paying a cost may instead be chosen by
+// the server, by submitting <name>-auto-pay and, optionally, <name>-keep-coins counts.
+type ActionField struct {
+	Name    string
+	Kind    string
+	Value   string
+	Options []string
+	AutoPay bool
+}
+
+// LegalAction describes an action the user may submit to Game.Update.  Warning, where provided,
+// is shown before the action is taken, as for actions that reveal hidden information.  Prompt,
+// where provided, describes the decision the action makes, as during the resolution of a ministry.
+type LegalAction struct {
+	Action  string
+	Fields  []*ActionField
+	Reveals bool
+	Warning string
+	Prompt  string
+}
+
+// resolutionActions are the actions deciding how the resolution of a ministry continues.
+var resolutionActions = map[string]bool{
+	"temp-transfer-influence": true,
+}
+
+func cards(name string) *ActionField {
+	return &ActionField{Name: name, Kind: cardsField}
+}
+
+func payment(name string) *ActionField {
+	return &ActionField{Name: name, Kind: cardsField, AutoPay: true}
+}
+
+func number(name string) *ActionField {
+	return &ActionField{Name: name, Kind: numberField}
+}
+
+func fixed(name, value string) *ActionField {
+	return &ActionField{Name: name, Kind: fixedField, Value: value}
+}
+
+// LegalActions returns the actions the user may presently submit and the form values each requires.
+func (g *Game) LegalActions(cu *user.User) []*LegalAction {
+	var as []*LegalAction
+	add := func(enabled bool, action string, fields ...*ActionField) {
+		if !enabled {
+			return
+		}
+		a := &LegalAction{Action: action, Fields: fields, Reveals: g.Reveals(action)}
+		if a.Reveals {
+			a.Warning = g.Locale().Sprintf("action.reveals")
+		}
+		if resolutionActions[action] {
+			a.Prompt = g.ResolutionPrompt()
+		}
+		as = append(as, a)
+	}
+
+	if !g.IsCurrentPlayer(cu) {
+		return as
+	}
+	cp := g.CurrentPlayer()
+
+	add(g.EnableBribeOfficial(cu), "bribe-official", payment("bribe-official"),
+		g.officialField("bribe-official", func(o *OfficialTile) bool { return o.NotBribed() }))
+	add(g.EnableSecureOfficial(cu), "secure-official", payment("secure-official"),
+		g.officialField("secure-official", func(o *OfficialTile) bool { return o.Player().Equal(cp) && !o.Secured }))
+	add(g.EnableNominateStudent(cu), "nominate-student", payment("nominate-student"))
+	add(g.EnableForceExam(cu), "force-exam", cards("force-exam"))
+	add(g.EnableBuyJunks(cu), "buy-junks", payment("buy-junks"), number("junks"))
+	add(g.EnableStartVoyage(cu), "start-voyage", cards("start-voyage"), number("junks"))
+	add(g.EnableChooseDistantLand(cu), "choose-distant-land", g.landField("distant-land", g.eligibleDistantLandsFor(cp)))
+	add(g.EnableRecruitArmy(cu), "recruit-army", payment("recruit-army"))
+	add(g.EnableInvadeLand(cu), "invade-land", payment("invade-land"),
+		g.boxField("invade-land", func(box *ForeignLandBox) bool { return box.NotInvaded() }))
+	add(g.EnableBuyGift(cu), "buy-gift", payment("buy-gift"), g.giftField("buy-gift", cp.GiftCardHand))
+	add(g.EnableGiveGift(cu), "give-gift", g.giftField("give-gift", cp.GiftsBought),
+		g.playerField("give-gift-player", func(p *Player) bool { return p.NotEqual(cp) }))
+	add(g.EnableCommercial(cu), "commercial", cards("commercial"))
+	add(g.EnableTaxIncome(cu), "tax-income")
+	add(g.EnableNoAction(cu), "no-action")
+
+	if g.EnablePetitionEmperor(cu) {
+		add(true, "move-junks",
+			g.playerField("move-junks-from-player", func(p *Player) bool { return p.Junks > 0 }),
+			g.playerField("move-junks-to-player", nil))
+		add(true, "replace-student", g.playerField("replace-student-player", func(p *Player) bool {
+			c := g.Candidate()
+			return c != nil && p.NotEqual(cp) && (c.Player().Equal(p) || c.OtherPlayer().Equal(p))
+		}))
+		add(true, "swap-officials",
+			g.officialField("swap-your-official", func(o *OfficialTile) bool { return o.Player().Equal(cp) }),
+			g.officialField("swap-other-official", func(o *OfficialTile) bool { return o.Bribed() && o.Player().NotEqual(cp) }))
+		add(true, "redeploy-army",
+			g.boxField("from-land", func(box *ForeignLandBox) bool { return box.Player().Equal(cp) }),
+			g.boxField("to-land", func(box *ForeignLandBox) bool { return box.NotInvaded() }))
+		add(true, "replace-influence",
+			g.officialField("replace-influence-official", func(o *OfficialTile) bool { return o.Bribed() && !o.Secured }),
+			g.playerField("replace-influence-player", nil))
+	}
+
+	if g.EnableEmperorReward(cu) {
+		for _, card := range cp.EmperorHand {
+			reward := fixed("reward-card", strconv.Itoa(int(card.Type)))
+			switch card.Type {
+			case Cash:
+				add(true, "take-cash", reward)
+			case FreeGift:
+				add(true, "take-gift", reward, g.giftField("take-gift", nil))
+			case ExtraAction:
+				add(true, "take-extra-action", reward)
+			case RecruitFreeArmy:
+				add(true, "take-army", reward)
+			case EmperorInsulted:
+				add(true, "avenge-emperor", reward)
+			default:
+				ms := g.emperorsRewardMinistriesFor(card)
+				add(len(ms) > 0, "take-bribery-reward", reward, cards("take-bribery-reward"),
+					g.officialField(fmt.Sprintf("take-bribery-reward-official-%d", card.Type), func(o *OfficialTile) bool {
+						return ms.Include(o.ministry) && !o.Secured && o.Player().NotEqual(cp)
+					}))
+			}
+		}
+	}
+
+	add(g.EnableTransferInfluence(cu), "transfer-influence",
+		g.officialField("transfer-influence-official", func(o *OfficialTile) bool { return o.Player().Equal(cp) }),
+		g.playerField("transfer-influence-player", func(p *Player) bool { return p.NotEqual(cp) }))
+	add(g.EnableTempTransfer(cu), "temp-transfer-influence", g.decisionField("temp-transfer-player"))
+	add(g.EnablePass(cu), "pass")
+
+	if g.EnablePlaceStudent(cu) {
+		f := &ActionField{Name: "official", Kind: officialField}
+		for _, id := range ministeryIDS {
+			m, ok := g.MinistriesFor(g.Candidate())[id]
+			if !ok {
+				continue
+			}
+			spots := m.emptyCandidateSpots()
+			if len(spots) == 0 {
+				spots = m.unbribedUnsecuredCandidateSpots()
+			}
+			for _, seniority := range spots {
+				f.Options = append(f.Options, fmt.Sprintf("%s-%d", m.Name(), seniority))
+			}
+		}
+		add(true, "place-student", f)
+	}
+
+	add(g.EnableDiscard(cu), "discard", cards("discard"))
+	add(g.EnableChooseChiefMinister(cu), "choose-chief-minister",
+		g.playerField("player", func(p *Player) bool { return p.NotEqual(cp) }))
+	add(g.EnableTutorStudent(cu), "tutor-student", cards("tutor-student"), g.playerField("player", nil))
+	return as
+}
+
+// officialField returns a field selecting an official of an unresolved ministry passing the test.
+func (g *Game) officialField(name string, test OfficialTest) *ActionField {
+	f := &ActionField{Name: name, Kind: officialField}
+	for _, id := range ministeryIDS {
+		m := g.Ministries[id]
+		if m == nil || m.Resolved {
+			continue
+		}
+
+		var ss Seniorities
+		for seniority, o := range m.Officials {
+			if test(o) {
+				ss = append(ss, seniority)
+			}
+		}
+		sort.Slice(ss, func(i, j int) bool { return ss[i] < ss[j] })
+		for _, seniority := range ss {
+			f.Options = append(f.Options, fmt.Sprintf("%s-%d", m.Name(), seniority))
+		}
+	}
+	return f
+}
+
+// boxField returns a field selecting a box of an unresolved foreign land passing the test.
+func (g *Game) boxField(name string, test BoxTest) *ActionField {
+	f := &ActionField{Name: name, Kind: boxField}
+	for i, land := range g.ForeignLands {
+		if land.Resolved {
+			continue
+		}
+		for j, box := range land.Boxes {
+			if test(box) {
+				f.Options = append(f.Options, fmt.Sprintf("%d-%d", i, j))
+			}
+		}
+	}
+	return f
+}
+
+// playerField returns a field selecting a player passing the test, or any player if test is nil.
+func (g *Game) playerField(name string, test PlayerTest) *ActionField {
+	f := &ActionField{Name: name, Kind: playerField}
+	for _, p := range g.Players() {
+		if test == nil || test(p) {
+			f.Options = append(f.Options, strconv.Itoa(p.ID()))
+		}
+	}
+	return f
+}
+
+// decisionField returns a field selecting one of the players among whom the awaited decision chooses.
+func (g *Game) decisionField(name string) *ActionField {
+	f := &ActionField{Name: name, Kind: playerField}
+	if g.Decision != nil {
+		for _, id := range g.Decision.OptionIDS {
+			f.Options = append(f.Options, strconv.Itoa(id))
+		}
+	}
+	return f
+}
+
+// landField returns a field selecting one of the distant lands.
+func (g *Game) landField(name string, lands DistantLands) *ActionField {
+	f := &ActionField{Name: name, Kind: landField}
+	for _, land := range lands {
+		f.Options = append(f.Options, strconv.Itoa(int(land.ID)))
+	}
+	return f
+}
+
+// giftField returns a field selecting one of the gifts, or any gift value if gifts is nil.
+func (g *Game) giftField(name string, gifts GiftCards) *ActionField {
+	f := &ActionField{Name: name, Kind: giftField}
+	if gifts == nil {
+		for _, v := range giftCardValues {
+			f.Options = append(f.Options, strconv.Itoa(v.Int()))
+		}
+		return f
+	}
+	for _, gift := range gifts {
+		f.Options = append(f.Options, strconv.Itoa(gift.Value.Int()))
+	}
+	return f
+}
+
+func (client *Client) actionsJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	c.JSON(http.StatusOK, g.actionsData(cu))
+}
+
+// actionsData returns the legal and unavailable actions of the user, as served to clients such as
+// confucius-cli.
+func (g *Game) actionsData(cu *user.User) gin.H {
+	return gin.H{
+		"Actions":     g.LegalActions(cu),
+		"Unavailable": g.UnavailableActions(cu),
+		"Stalled":     g.Stalled(),
+	}
+}
@@ -20,7 +20,7 @@ func (p *Player) newEntry() *Entry {
 }
 
 func (e *Entry) PhaseName() string {
-	return PhaseNames[e.Phase()]
+	return e.locale().Name(PhaseNames[e.Phase()])
 }
 
 func pluralize(label string, value int) string {
@@ -109,21 +109,13 @@ func (mc MinistryChit) Value() int {
 type MinistryChits []MinistryChit
 
 func (g *Game) setMinistryChits() {
-	mcs := []MinistryChit{4, 4, 5, 5, 6, 6, 7, 7, 8, 8}
-	for _, m := range g.Ministries {
-		m.setMinistryChits(mcs)
+	mcs := g.drawMinistryChits()
+	for i, id := range ministeryIDS {
+		g.Ministries[id].setMinistryChits(mcs[2*i], mcs[2*i+1])
 	}
 }
 
-func (m *Ministry) setMinistryChits(mcs MinistryChits) {
-	i := sn.MyRand.Intn(len(mcs))
-	chit1 := mcs[i]
-	mcs = append(mcs[:i], mcs[i+1:]...)
-
-	i = sn.MyRand.Intn(len(mcs))
-	chit2 := mcs[i]
-	mcs = append(mcs[:i], mcs[i+1:]...)
-
+func (m *Ministry) setMinistryChits(chit1, chit2 MinistryChit) {
 	if chit1 > chit2 {
 		m.MinisterChit = chit1
 		m.SecretaryChit = chit2
@@ -283,14 +275,17 @@ func (od *OfficialsDeck) Draw() *OfficialTile {
 	return tile
 }
 
+// DrawS draws the top tile of the deck, returning nil if the deck is empty.
 func (od OfficialsDeck) DrawS() (OfficialsDeck, *OfficialTile) {
-	var tiles OfficialsDeck
-	var tile *OfficialTile
+	if len(od) == 0 {
+		return od, nil
+	}
+	return od[1:], od[0]
+}
 
-	i := Seniority(sn.MyRand.Intn(len(od)))
-	tile = od[i]
-	tiles = append(od[:i], od[i+1:]...)
-	return tiles, tile
+// Shuffle shuffles the tiles in place.
+func (od OfficialsDeck) Shuffle() {
+	sn.MyRand.Shuffle(len(od), func(i, j int) { od[i], od[j] = od[j], od[i] })
 }
 
 func (g *Game) OfficialTiles() OfficialsDeck {
@@ -370,6 +365,7 @@ func NewOfficialsDeck() OfficialsDeck {
 	for _, variant := range []VariantID{First, Second, Third} {
 		deck = append(deck, &OfficialTile{PlayerID: NoPlayerID, TempID: NoPlayerID, Cost: 7, Variant: variant})
 	}
+	deck.Shuffle()
 	return deck
 }
 
@@ -1,7 +1,6 @@
 package confucius
 
 import (
-	"fmt"
 	"html/template"
 
 	"github.com/SlothNinja/contest"
@@ -11,25 +10,28 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func (g *Game) ministryResolutionPhase(c *gin.Context, ending bool) bool {
+func (g *Game) ministryResolutionPhase(c *gin.Context, ending bool) (bool, error) {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	g.Phase = MinistryResolution
-
-	// Clear Players to enable them to perform an action this phase.
-	g.beginningOfPhaseReset()
+	phase := MinistryResolution
+	if ending {
+		phase = FinalMinistryResolution
+	}
+	if err := g.setPhase(phase); err != nil {
+		return false, err
+	}
 
 	for _, mid := range []MinistryID{Bingbu, Hubu, Gongbu} {
 		m := g.Ministries[mid]
 		if !m.Resolved && (ending || m.MarkerCount() == 7) {
 			completed := g.initMinistryResolution(c, m)
 			if !completed {
-				return completed
+				return completed, nil
 			}
 		}
 	}
-	return true
+	return true, nil
 }
 
 func (g *Game) ministryInProgress() *Ministry {
@@ -50,85 +52,202 @@ func (g *Game) initMinistryResolution(c *gin.Context, m *Ministry) bool {
 		o.Secured = true
 		o.setTempPlayer(o.Player())
 	}
-	return g.resolve(c, m)
+	return g.advanceResolution(m)
 }
 
-func (g *Game) playerCountsIn(m *Ministry) map[int]int {
-	counts := make(map[int]int)
-	for _, o := range m.Officials {
-		if o.TempPlayer() != nil {
-			counts[o.TempPlayer().ID()] += 1
+// ResolutionStep identifies a decision awaited during the resolution of a ministry.
+type ResolutionStep int
+
+const (
+	NoResolutionStep     ResolutionStep = iota
+	ChooseTransferTarget                // A player chooses to whom they temporarily transfer influence
+)
+
+// ResolutionDecision records a decision awaited during the resolution of a ministry: the player
+// deciding and the ids of the players among whom they choose.
+type ResolutionDecision struct {
+	Step      ResolutionStep
+	PlayerID  int
+	OptionIDS []int
+}
+
+// Includes reports whether the player is among the options of the decision.
+func (d *ResolutionDecision) Includes(p *Player) bool {
+	return d != nil && p != nil && includeID(d.OptionIDS, p.ID())
+}
+
+// Options returns the players among whom the decision chooses.
+func (d *ResolutionDecision) Options(g *Game) Players {
+	var ps Players
+	for _, id := range d.OptionIDS {
+		if p := g.PlayerByID(id); p != nil {
+			ps = append(ps, p)
 		}
 	}
-	return counts
+	return ps
 }
 
-func (g *Game) resolve(c *gin.Context, m *Ministry) bool {
+// advanceResolution transfers temporary influence in the ministry until no more than two players
+// hold influence, and then appoints its minister and secretary.  It returns false, leaving the
+// decision awaited and its player current, where a player must decide how resolution continues.
+func (g *Game) advanceResolution(m *Ministry) bool {
 	log.Debugf(msgEnter)
 	defer log.Debugf(msgExit)
 
-	var playerCounts map[int]int
-	for playerCounts = g.playerCountsIn(m); len(playerCounts) > 2; playerCounts = g.playerCountsIn(m) {
-		from := m.playerToTempTransfer(playerCounts)
-		g.SetCurrentPlayerers(from)
-		to := from.TempPlayers()
+	if m == nil {
+		return true
+	}
+
+	for {
+		d := g.Decision
+		if d == nil {
+			d = g.nextDecision(m)
+		}
+		if d == nil {
+			break
+		}
 
-		if len(to) != 1 {
-			log.Debugf("to: %#v", to)
-			log.Debugf("ministry: %#v", m)
-			log.Debugf("playerCounts: %#v", playerCounts)
+		g.Decision = nil
+		if p := d.awaits(g); p != nil {
+			g.Decision = d
+			p.PerformedAction = false
+			g.SetCurrentPlayerers(p)
 			return false
 		}
-		g.autoTempTransferInfluence(from, to[0])
+		g.applyDefault(m, d, false)
 	}
 
-	ministerID, secretaryID := NoPlayerID, NoPlayerID
-	switch len(playerCounts) {
-	case 1:
-		for pid := range playerCounts {
-			ministerID, secretaryID = pid, pid
-		}
-	case 2:
-		var ministerCount int
-		for pid, count := range playerCounts {
-			switch {
-			case ministerCount == 0:
-				ministerID, ministerCount = pid, count
-			case ministerCount > count:
-				secretaryID = pid
-			case ministerCount < count:
-				secretaryID = ministerID
-				ministerID, ministerCount = pid, count
-			case ministerCount == count:
-				var official *OfficialTile
-				for _, seniority := range []Seniority{1, 2, 3, 4, 5, 6, 7} {
-					var ok bool
-					official, ok = m.Officials[seniority]
-					if ok && official.TempID != NoPlayerID {
-						break
-					}
-				}
-				switch official.TempID {
-				case pid:
-					secretaryID = ministerID
-					ministerID, ministerCount = pid, count
-				default:
-					secretaryID = pid
-				}
-			}
+	g.appoint(m)
+	return true
+}
+
+// awaits returns the player who must make the decision, or nil if the decision is made by rule,
+// as where there is but one option or the player is the neutral family or missing.
+func (d *ResolutionDecision) awaits(g *Game) *Player {
+	if p := g.PlayerByID(d.PlayerID); len(d.OptionIDS) > 1 && p != nil && !p.isNeutral() {
+		return p
+	}
+	return nil
+}
+
+// migrateDecision records the decision awaited in games saved, while a ministry was resolved, before
+// such decisions were recorded.
+func (g *Game) migrateDecision() {
+	m := g.ministryInProgress()
+	if m == nil || g.Decision != nil {
+		return
+	}
+
+	cp := g.CurrentPlayer()
+	if cp != nil && cp.PerformedAction {
+		return
+	}
+
+	if d := g.nextDecision(m); d != nil {
+		if p := d.awaits(g); p != nil {
+			g.Decision = d
+			g.SetCurrentPlayerers(p)
 		}
 	}
+}
+
+// nextDecision returns the decision by which resolution of the ministry continues, or nil if no
+// more than two players hold influence or no player can transfer influence.  The player having the
+// least influence transfers it, ties being broken by seniority.
+func (g *Game) nextDecision(m *Ministry) *ResolutionDecision {
+	inf := m.tempInfluence()
+	counts := inf.counts()
+	if len(counts) <= 2 {
+		return nil
+	}
+	return g.transferDecision(m, inf.lowestID(counts))
+}
+
+// transferDecision returns the decision of the player with the id as to whom they transfer
+// influence in the ministry, or nil if there is no such player or no player to whom to transfer.
+func (g *Game) transferDecision(m *Ministry, id int) *ResolutionDecision {
+	if g.PlayerByID(id) == nil {
+		return nil
+	}
+
+	d := &ResolutionDecision{Step: ChooseTransferTarget, PlayerID: id}
+	for _, p := range g.PlayerByID(id).TempPlayers() {
+		d.OptionIDS = append(d.OptionIDS, p.ID())
+	}
+	if len(d.OptionIDS) == 0 {
+		return nil
+	}
+	return d
+}
+
+// defaultOption returns the id of the player chosen where the decision is made without the
+// player: influence goes to the player having the most influence, ties going to the player holding
+// the more senior official.
+func (d *ResolutionDecision) defaultOption(m *Ministry) int {
+	id := m.tempInfluence().neutralTarget(d.OptionIDS)
+	if id == NoPlayerID {
+		return d.OptionIDS[0]
+	}
+	return id
+}
+
+// applyDefault makes the decision for its player, logging that it was made by default where the
+// player could have chosen but failed to do so in time.
+func (g *Game) applyDefault(m *Ministry, d *ResolutionDecision, byDefault bool) {
+	from, to := g.PlayerByID(d.PlayerID), g.PlayerByID(d.defaultOption(m))
+	gift := from.transferTempInfluenceTo(to)
+	from.newAutoTransferTempInfluenceInEntry(to, gift).Default = byDefault
+}
+
+// decideByDefault makes the awaited decision, if any, for its player, as where the player fails to
+// decide in time, and reports whether there was such a decision.  The turn of the player may then
+// be finished to continue resolution.
+func (g *Game) decideByDefault() bool {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	d, m := g.Decision, g.ministryInProgress()
+	if d == nil || m == nil {
+		return false
+	}
+
+	g.Decision = nil
+	g.applyDefault(m, d, true)
+	if p := g.PlayerByID(d.PlayerID); p != nil {
+		p.PerformedAction = true
+	}
+	return true
+}
+
+// ResolutionPrompt returns the prompt for the decision awaited during the resolution of a
+// ministry, or the empty string if there is none.
+func (g *Game) ResolutionPrompt() string {
+	d, m := g.Decision, g.ministryInProgress()
+	if d == nil || m == nil {
+		return ""
+	}
+
+	l := g.Locale()
+	var names []string
+	for _, p := range d.Options(g) {
+		names = append(names, g.NameFor(p))
+	}
+	return l.Sprintf("prompt.choose-transfer-target", g.NameFor(g.PlayerByID(d.PlayerID)), l.Name(m.Name()), l.ToSentence(names))
+}
+
+func (g *Game) appoint(m *Ministry) {
+	ministerID, secretaryID := m.tempInfluence().appointments(m.tempInfluence().counts())
 
 	log.Debugf("ministerID: %#v", ministerID)
 	minister := g.PlayerByID(ministerID)
 	if minister != nil {
-		minister.Score += m.MinisterChit.Value()
+		minister.addScore(MinisterScore, m.MinisterChit.Value())
 	}
 
 	log.Debugf("secretaryID: %#v", secretaryID)
 	secretary := g.PlayerByID(secretaryID)
 	if secretary != nil {
-		secretary.Score += m.SecretaryChit.Value()
+		secretary.addScore(SecretaryScore, m.SecretaryChit.Value())
 	}
 
 	// Create ActionLog Entry
@@ -148,8 +267,7 @@ func (g *Game) resolve(c *gin.Context, m *Ministry) bool {
 	m.setSecretary(secretary)
 	m.Resolved = true
 	m.InProgress = false
-
-	return true
+	g.emit(&MinistryResolved{Ministry: m.Name(), MinisterID: ministerID, SecretaryID: secretaryID})
 }
 
 type resolvedMinistryEntry struct {
@@ -170,54 +288,21 @@ func (g *Game) newResolvedMinistryEntry() *resolvedMinistryEntry {
 
 func (m *resolvedMinistryEntry) HTML() template.HTML {
 	g := m.Game().(*Game)
-	s := fmt.Sprintf("<div>%s Ministry Resolved</div>", m.MinistryName)
+	l := m.locale()
+	s := "<div>" + l.Sprintf("entry.ministry-resolved", l.Name(m.MinistryName)) + "</div>"
 	if minister := g.PlayerByID(m.MinisterID); minister != nil {
-		s += fmt.Sprintf("<div>%s awarded Minister position and %d points</div>", g.NameFor(minister), m.MinisterScore)
+		s += "<div>" + l.Sprintf("entry.minister-awarded", g.NameFor(minister), m.MinisterScore) + "</div>"
 	} else {
-		s += fmt.Sprintf("<div>No one awarded Minister position</div>")
+		s += "<div>" + l.Sprintf("entry.no-minister") + "</div>"
 	}
 	if secretary := m.Game().(*Game).PlayerByID(m.SecretaryID); secretary != nil {
-		s += fmt.Sprintf("<div>%s awarded Secretary position and %d points</div>", g.NameFor(secretary), m.SecretaryScore)
+		s += "<div>" + l.Sprintf("entry.secretary-awarded", g.NameFor(secretary), m.SecretaryScore) + "</div>"
 	} else {
-		s += fmt.Sprintf("<div>No one awarded Secretary position</div>")
+		s += "<div>" + l.Sprintf("entry.no-secretary") + "</div>"
 	}
 	return restful.HTML(s)
 }
 
-func (m *Ministry) playerToTempTransfer(playerCounts map[int]int) *Player {
-	min := 7
-	var pids []int
-
-	for pid, count := range playerCounts {
-		if count == min {
-			pids = append(pids, pid)
-		} else if count < min {
-			min = count
-			pids = []int{pid}
-		}
-	}
-
-	if len(pids) == 1 {
-		return m.Game().PlayerByID(pids[0])
-	}
-
-	for _, seniority := range []Seniority{1, 2, 3, 4, 5, 6, 7} {
-		official, ok := m.Officials[seniority]
-		if !ok {
-			continue
-		}
-		for i, pid := range pids {
-			if official.TempID == pid {
-				pids = append(pids[:i], pids[i+1:]...)
-			}
-			if len(pids) == 1 {
-				return m.Game().PlayerByID(pids[0])
-			}
-		}
-	}
-	return nil
-}
-
 func (client *Client) ministryResolutionFinishTurn(c *gin.Context, g *Game, cu *user.User) (*user.Stats, []*contest.Contest, error) {
 	client.Log.Debugf(msgEnter)
 	defer client.Log.Debugf(msgExit)
@@ -228,18 +313,29 @@ func (client *Client) ministryResolutionFinishTurn(c *gin.Context, g *Game, cu *
 	}
 
 	cp := g.CurrentPlayer()
-	restful.AddNoticef(c, "%s finished turn.", g.NameFor(cp))
-	resolved := g.resolve(c, g.ministryInProgress())
+	restful.AddNoticef(c, "%s", g.Locale().Sprintf("notice.finished-turn", g.NameFor(cp)))
+	ending := g.Phase == FinalMinistryResolution
+	resolved := g.advanceResolution(g.ministryInProgress())
 	if !resolved {
 		return s, nil, nil
 	}
 
-	completed := g.ministryResolutionPhase(c, false)
+	completed, err := g.ministryResolutionPhase(c, ending)
+	if err != nil {
+		return nil, nil, err
+	}
 	if !completed {
 		return s, nil, nil
 	}
 
-	g.invasionPhase(c)
+	if ending {
+		cs, err := client.endGameScoring(c, g)
+		return s, cs, err
+	}
+
+	if err := g.invasionPhase(c); err != nil {
+		return nil, nil, err
+	}
 	cs, err := client.endOfRoundPhase(c, g)
 	return s, cs, err
 }
@@ -0,0 +1,225 @@
+package confucius
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/SlothNinja/game"
+	"github.com/gin-gonic/gin"
+)
+
+// newResolutionGame returns a game of n players with the variants in which the Bingbu ministry is
+// held as given, its officials costing 1, and the gifts given.
+func newResolutionGame(t *testing.T, n int, vs []GameVariantID, holders map[Seniority]int,
+	gifts []testGift) (*gin.Context, *Game, *Ministry) {
+	t.Helper()
+
+	c, g := newTestGame(t, n, vs...)
+	m := g.Ministries[Bingbu]
+	costs := make(map[Seniority]int)
+	for seniority := range holders {
+		costs[seniority] = 1
+	}
+	setOfficials(m, costs, holders)
+	for _, gift := range gifts {
+		giveTestGift(g, gift.from, gift.to, gift.value)
+	}
+	g.Phase = MinistryResolution
+	return c, g, m
+}
+
+func TestMinistryResolutionDecisions(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		p2 = 2
+		n  = NeutralPlayerID
+	)
+	tests := []struct {
+		name          string
+		players       int
+		variants      []GameVariantID
+		holders       map[Seniority]int
+		gifts         []testGift
+		wantDecider   int
+		wantOptions   []int
+		wantMinister  int
+		wantSecretary int
+	}{
+		{
+			name:        "several gift obligations",
+			players:     3,
+			holders:     map[Seniority]int{1: p1, 2: p1, 3: p2, 4: p2, 5: p0},
+			gifts:       []testGift{{p1, p0, 2}, {p2, p0, 2}},
+			wantDecider: p0,
+			wantOptions: []int{p1, p2},
+		},
+		{
+			name:          "most valuable gift obligation",
+			players:       3,
+			holders:       map[Seniority]int{1: p1, 2: p1, 3: p2, 4: p2, 5: p0},
+			gifts:         []testGift{{p1, p0, 1}, {p2, p0, 3}},
+			wantDecider:   NoPlayerID,
+			wantMinister:  p2,
+			wantSecretary: p1,
+		},
+		{
+			name:        "no gift obligations",
+			players:     3,
+			holders:     map[Seniority]int{1: p1, 2: p1, 3: p2, 4: p2, 5: p0},
+			wantDecider: p0,
+			wantOptions: []int{p1, p2},
+		},
+		{
+			name:        "tie broken by seniority",
+			players:     3,
+			holders:     map[Seniority]int{1: p0, 2: p1, 3: p2},
+			wantDecider: p2,
+			wantOptions: []int{p0, p1},
+		},
+		{
+			name:          "neutral family",
+			players:       2,
+			variants:      []GameVariantID{TwoPlayerVariant},
+			holders:       map[Seniority]int{1: p1, 2: p0, 3: p0, 4: p1, 5: n},
+			wantDecider:   NoPlayerID,
+			wantMinister:  p1,
+			wantSecretary: p0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g, m := newResolutionGame(t, tt.players, tt.variants, tt.holders, tt.gifts)
+
+			done := g.initMinistryResolution(c, m)
+			if tt.wantDecider == NoPlayerID {
+				switch {
+				case !done:
+					t.Fatalf("resolution awaits decision %+v", g.Decision)
+				case m.MinisterID != tt.wantMinister:
+					t.Errorf("minister: got %d, want %d", m.MinisterID, tt.wantMinister)
+				case m.SecretaryID != tt.wantSecretary:
+					t.Errorf("secretary: got %d, want %d", m.SecretaryID, tt.wantSecretary)
+				}
+				return
+			}
+
+			d := g.Decision
+			switch {
+			case done || d == nil:
+				t.Fatal("resolution completed, want decision awaited")
+			case d.Step != ChooseTransferTarget || d.PlayerID != tt.wantDecider:
+				t.Errorf("decision: got %+v, want transfer by %d", d, tt.wantDecider)
+			case !reflect.DeepEqual(d.OptionIDS, tt.wantOptions):
+				t.Errorf("options: got %v, want %v", d.OptionIDS, tt.wantOptions)
+			case g.CurrentPlayer().ID() != tt.wantDecider:
+				t.Errorf("current player: got %d, want %d", g.CurrentPlayer().ID(), tt.wantDecider)
+			}
+		})
+	}
+}
+
+func TestChooseTransferTarget(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		p2 = 2
+	)
+	holders := map[Seniority]int{1: p1, 2: p1, 3: p2, 4: p2, 5: p0}
+	tests := []struct {
+		name    string
+		to      int
+		wantErr bool
+	}{
+		{"first obligation", p1, false},
+		{"second obligation", p2, false},
+		{"not an option", p0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g, m := newResolutionGame(t, 3, nil, holders, []testGift{{p1, p0, 2}, {p2, p0, 2}})
+			if g.initMinistryResolution(c, m) {
+				t.Fatal("resolution completed, want decision awaited")
+			}
+			cp := g.CurrentPlayer()
+			postForm(c, url.Values{"action": {"temp-transfer-influence"},
+				"temp-transfer-player": {strconv.Itoa(tt.to)}})
+
+			_, actionType, err := g.Update(c, g.Users[cp.ID()])
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("error: got %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			switch {
+			case actionType != game.Cache:
+				t.Errorf("action type: got %v, want %v", actionType, game.Cache)
+			case g.Decision != nil:
+				t.Errorf("decision: got %+v, want none", g.Decision)
+			case m.Officials[5].TempPlayer().ID() != tt.to:
+				t.Errorf("influence: got %d, want %d", m.Officials[5].TempPlayer().ID(), tt.to)
+			case len(cp.GiftsReceived) != 1:
+				t.Errorf("gifts received: got %d, want 1", len(cp.GiftsReceived))
+			}
+		})
+	}
+}
+
+func TestMigrateDecision(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		p2 = 2
+	)
+	tests := []struct {
+		name        string
+		inProgress  bool
+		performed   bool
+		wantDecider int
+	}{
+		{"decision awaited", true, false, p0},
+		{"turn performed", true, true, NoPlayerID},
+		{"no ministry in progress", false, false, NoPlayerID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holders := map[Seniority]int{1: p1, 2: p1, 3: p2, 4: p2, 5: p0}
+			_, g, m := newResolutionGame(t, 3, nil, holders, nil)
+
+			// As saved before decisions were recorded: temporary influence set, but no decision.
+			m.InProgress = tt.inProgress
+			for _, o := range m.Officials {
+				o.Secured = true
+				o.setTempPlayer(o.Player())
+			}
+			g.SetCurrentPlayerers(g.PlayerByID(p1))
+			g.CurrentPlayer().PerformedAction = tt.performed
+
+			g.migrateDecision()
+			if tt.wantDecider == NoPlayerID {
+				if g.Decision != nil {
+					t.Errorf("decision: got %+v, want none", g.Decision)
+				}
+				if cp := g.CurrentPlayer(); cp.ID() != p1 {
+					t.Errorf("current player: got %d, want %d", cp.ID(), p1)
+				}
+				return
+			}
+
+			switch d := g.Decision; {
+			case d == nil:
+				t.Fatal("decision: got none")
+			case d.PlayerID != tt.wantDecider || !reflect.DeepEqual(d.OptionIDS, []int{p1, p2}):
+				t.Errorf("decision: got %+v, want transfer by %d to %v", d, tt.wantDecider, []int{p1, p2})
+			case g.CurrentPlayer().ID() != tt.wantDecider:
+				t.Errorf("current player: got %d, want %d", g.CurrentPlayer().ID(), tt.wantDecider)
+			}
+		})
+	}
+}
@@ -0,0 +1,710 @@
+package confucius
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/codec"
+	"github.com/SlothNinja/mlog"
+	"github.com/SlothNinja/sn"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+const moderationKind = "Moderation"
+
+// Limits on the messages of the message log.
+const (
+	maxMessageLength  = 1000
+	messageRateLimit  = 5
+	messageRateWindow = time.Minute
+	messageEditWindow = 10 * time.Minute
+)
+
+// Text replacing messages deleted by their authors or hidden by admins.
+const (
+	deletedMessageText = "[message deleted]"
+	hiddenMessageText  = "[message hidden by moderator]"
+)
+
+// Moderation records the moderation of the message log of a game.  Pending provides the number of
+// unresolved reports, so that games needing attention can be queried for the moderation queue.
+type Moderation struct {
+	Key              *datastore.Key `datastore:"__key__"`
+	Pending          int
+	SavedState       []byte `datastore:",noindex"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	*ModerationState `datastore:"-"`
+
+	locale Locale
+}
+
+// ModerationState provides the indices of the hidden and deleted messages, the muted users and the
+// reports of abusive messages.
+type ModerationState struct {
+	Hidden  []int
+	Deleted []int
+	Muted   []int64
+	Reports []*MessageReport
+}
+
+// MessageReport is a report of an abusive message into the moderation queue.  The message is
+// copied into the report, so that admins see what was reported even if the message is later
+// edited or deleted.
+type MessageReport struct {
+	ID           int
+	MessageIndex int
+	AuthorID     int64
+	AuthorName   string
+	Text         string
+	ReporterID   int64
+	ReporterName string
+	Reason       string
+	CreatedAt    time.Time
+	Resolved     bool
+}
+
+func newModerationKey(c *gin.Context, id int64) *datastore.Key {
+	return datastore.IDKey(moderationKind, id, pk(c))
+}
+
+func newModeration(c *gin.Context, id int64) *Moderation {
+	return &Moderation{
+		Key:             newModerationKey(c, id),
+		ModerationState: new(ModerationState),
+		locale:          localeFrom(c),
+	}
+}
+
+func (mod *Moderation) Load(ps []datastore.Property) error {
+	err := datastore.LoadStruct(mod, ps)
+	if err != nil {
+		return err
+	}
+
+	s := new(ModerationState)
+	err = codec.Decode(s, mod.SavedState)
+	if err != nil {
+		return err
+	}
+	mod.ModerationState = s
+	return nil
+}
+
+func (mod *Moderation) Save() ([]datastore.Property, error) {
+	v, err := codec.Encode(mod.ModerationState)
+	if err != nil {
+		return nil, err
+	}
+	mod.SavedState = v
+	mod.Pending = mod.pending()
+	return datastore.SaveStruct(mod)
+}
+
+func (mod *Moderation) LoadKey(k *datastore.Key) error {
+	mod.Key = k
+	return nil
+}
+
+func (mod *Moderation) pending() int {
+	count := 0
+	for _, r := range mod.Reports {
+		if !r.Resolved {
+			count += 1
+		}
+	}
+	return count
+}
+
+func (mod *Moderation) isHidden(i int) bool {
+	return includeID(mod.Hidden, i)
+}
+
+func (mod *Moderation) isDeleted(i int) bool {
+	return includeID(mod.Deleted, i)
+}
+
+func (mod *Moderation) isMuted(uid int64) bool {
+	for _, id := range mod.Muted {
+		if id == uid {
+			return true
+		}
+	}
+	return false
+}
+
+func (mod *Moderation) setHidden(i int, hidden bool) {
+	mod.Hidden = removeID(mod.Hidden, i)
+	if hidden {
+		mod.Hidden = append(mod.Hidden, i)
+		mod.resolveReportsFor(i)
+	}
+}
+
+func (mod *Moderation) setMuted(uid int64, muted bool) {
+	for i, id := range mod.Muted {
+		if id == uid {
+			mod.Muted = append(mod.Muted[:i], mod.Muted[i+1:]...)
+			break
+		}
+	}
+	if muted {
+		mod.Muted = append(mod.Muted, uid)
+	}
+}
+
+func (mod *Moderation) resolveReportsFor(i int) {
+	for _, r := range mod.Reports {
+		if r.MessageIndex == i {
+			r.Resolved = true
+		}
+	}
+}
+
+func (mod *Moderation) report(id int) *MessageReport {
+	for _, r := range mod.Reports {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func removeID(ids []int, id int) []int {
+	for i, v := range ids {
+		if v == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+	return ids
+}
+
+// moderated returns a copy of the message log in which hidden messages are replaced, unless
+// the user is an admin.  Messages keep their positions, so indices remain valid.
+func (mod *Moderation) moderated(ml *mlog.MLog, cu *user.User) *mlog.MLog {
+	if cu.IsAdmin() || len(mod.Hidden) == 0 {
+		return ml
+	}
+
+	cp := *ml
+	cp.Messages = hideMessages(ml.Messages, mod.Hidden)
+	return &cp
+}
+
+// hideMessages returns a copy of the messages in which those at the hidden indices are replaced.
+func hideMessages(ms []*mlog.Message, hidden []int) []*mlog.Message {
+	cp := make([]*mlog.Message, len(ms))
+	for i, m := range ms {
+		cp[i] = m
+		if includeID(hidden, i) {
+			h := *m
+			h.Text = hiddenMessageText
+			cp[i] = &h
+		}
+	}
+	return cp
+}
+
+// validateMessage validates a message to be posted by the user to the message log.
+func (mod *Moderation) validateMessage(ml *mlog.MLog, cu *user.User, text string) error {
+	if mod.isMuted(cu.ID()) {
+		return mod.locale.VError("error.been-muted-game")
+	}
+	return validateNewMessage(mod.locale, ml.Messages, cu, text)
+}
+
+// validateNewMessage validates the length of a message to be posted by the user, and that the
+// user has not posted too many of the messages recently.
+func validateNewMessage(l Locale, ms []*mlog.Message, cu *user.User, text string) error {
+	if err := validateMessageText(l, text); err != nil {
+		return err
+	}
+
+	since := time.Now().Add(-messageRateWindow)
+	count := 0
+	for _, m := range ms {
+		if m.CreatorID == cu.ID() && m.CreatedAt.After(since) {
+			count += 1
+		}
+	}
+	if count >= messageRateLimit {
+		return l.VError("error.may-post-no-more-than-messages", messageRateLimit)
+	}
+	return nil
+}
+
+func validateMessageText(l Locale, text string) error {
+	switch length := utf8.RuneCountInString(text); {
+	case length == 0:
+		return l.VError("error.must-enter-message")
+	case length > maxMessageLength:
+		return l.VError("error.messages-limited-characters", maxMessageLength)
+	default:
+		return nil
+	}
+}
+
+// validateAuthorChange validates an edit or deletion of the message by the user.
+func (mod *Moderation) validateAuthorChange(ml *mlog.MLog, i int, cu *user.User) (*mlog.Message, error) {
+	return validateAuthorChange(mod.locale, ml.Messages, i, cu, mod.Deleted, mod.Hidden)
+}
+
+// validateAuthorChange validates an edit or deletion by the user of the message at index i, given
+// the indices of the deleted and hidden messages.
+func validateAuthorChange(l Locale, ms []*mlog.Message, i int, cu *user.User, deleted, hidden []int) (*mlog.Message, error) {
+	if i < 0 || i >= len(ms) {
+		return nil, l.VError("error.received-invalid-message")
+	}
+
+	m := ms[i]
+	switch {
+	case cu == nil || m.CreatorID != cu.ID():
+		return nil, l.VError("error.may-only-change-own-messages")
+	case includeID(deleted, i):
+		return nil, l.VError("error.message-been-deleted")
+	case includeID(hidden, i):
+		return nil, l.VError("error.message-been-hidden-by-moderator")
+	case time.Since(m.CreatedAt) > messageEditWindow:
+		return nil, l.VError("error.messages-may-only-changed-within-being", messageEditWindow)
+	}
+	return m, nil
+}
+
+func (client *Client) getModeration(c *gin.Context, id int64) (*Moderation, error) {
+	mod := newModeration(c, id)
+	err := client.DS.Get(c, mod.Key, mod)
+	if err == datastore.ErrNoSuchEntity {
+		return mod, nil
+	}
+	return mod, err
+}
+
+func (mod *Moderation) touch() {
+	t := time.Now()
+	if mod.CreatedAt.IsZero() {
+		mod.CreatedAt = t
+	}
+	mod.UpdatedAt = t
+}
+
+// txMessageLogs gets the message log and moderation of the game within the transaction.  Either
+// is empty if not yet saved.
+func txMessageLogs(c *gin.Context, tx *datastore.Transaction, id int64) (*mlog.MLog, *Moderation, error) {
+	ml := mlog.New(id)
+	err := tx.Get(ml.Key, ml)
+	if err != nil && err != datastore.ErrNoSuchEntity {
+		return nil, nil, err
+	}
+
+	mod := newModeration(c, id)
+	err = tx.Get(mod.Key, mod)
+	if err != nil && err != datastore.ErrNoSuchEntity {
+		return nil, nil, err
+	}
+	return ml, mod, nil
+}
+
+// updateModeration applies f to the moderation of the game in a transaction, so that concurrent
+// reports and moderation are not lost.  The message log is read, but not saved.
+func (client *Client) updateModeration(c *gin.Context, id int64, f func(*mlog.MLog, *Moderation) error) (*Moderation, error) {
+	var mod *Moderation
+	_, err := client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
+		var (
+			ml  *mlog.MLog
+			err error
+		)
+		ml, mod, err = txMessageLogs(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = f(ml, mod)
+		if err != nil {
+			return err
+		}
+
+		mod.touch()
+		_, err = tx.Put(mod.Key, mod)
+		return err
+	})
+	return mod, err
+}
+
+// updateMessageLog applies f to the message log and moderation of the game, saving both in a single
+// transaction, so that neither is saved without the other.
+func (client *Client) updateMessageLog(c *gin.Context, id int64, f func(*mlog.MLog, *Moderation) error) error {
+	var ml *mlog.MLog
+	_, err := client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
+		var (
+			mod *Moderation
+			err error
+		)
+		ml, mod, err = txMessageLogs(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		err = f(ml, mod)
+		if err != nil {
+			return err
+		}
+
+		mod.touch()
+		_, err = tx.PutMulti([]*datastore.Key{ml.Key, mod.Key}, []interface{}{ml, mod})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// The message log client caches message logs, so the cached log is replaced by the saved one.
+	client.Cache.SetDefault(ml.Key.Encode(), ml)
+	return nil
+}
+
+func getMessageIndex(c *gin.Context) (int, error) {
+	i, err := strconv.Atoi(c.Param("mid"))
+	if err != nil {
+		return -1, ErrInvalidID
+	}
+	return i, nil
+}
+
+// messageLogs returns the message log and moderation of the game identified by the request.
+func (client *Client) messageLogs(c *gin.Context) (*mlog.MLog, *Moderation, error) {
+	id, err := getID(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ml, err := client.MLog.Get(c, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mod, err := client.getModeration(c, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ml, mod, nil
+}
+
+// editMessage replaces the text of a message posted by the current user within the edit window.
+func (client *Client) editMessage(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	i, err := getMessageIndex(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	var m *mlog.Message
+	err = client.updateMessageLog(c, id, func(ml *mlog.MLog, mod *Moderation) error {
+		var err error
+		m, err = mod.validateAuthorChange(ml, i, cu)
+		if err != nil {
+			return err
+		}
+		err = validateMessageText(mod.locale, c.PostForm("message"))
+		if err != nil {
+			return err
+		}
+
+		m.Text = c.PostForm("message")
+		m.UpdatedAt = time.Now()
+		return nil
+	})
+	if sn.IsVError(err) {
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.HTML(http.StatusOK, "shared/message", gin.H{
+		"message": m,
+		"ctx":     c,
+		"map":     gameFrom(c).ColorMapFor(cu),
+		"link":    cu.Link(),
+	})
+}
+
+// deleteMessage removes the text of a message posted by the current user within the edit window.
+func (client *Client) deleteMessage(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	i, err := getMessageIndex(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	err = client.updateMessageLog(c, id, func(ml *mlog.MLog, mod *Moderation) error {
+		m, err := mod.validateAuthorChange(ml, i, cu)
+		if err != nil {
+			return err
+		}
+
+		m.Text = deletedMessageText
+		m.UpdatedAt = time.Now()
+		mod.Deleted = append(mod.Deleted, i)
+		mod.resolveReportsFor(i)
+		return nil
+	})
+	if sn.IsVError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"Error": err.Error()})
+		return
+	}
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Deleted": i})
+}
+
+// reportMessage adds a report of an abusive message to the moderation queue.
+func (client *Client) reportMessage(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	i, err := getMessageIndex(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil || cu == nil || !g.HasUser(cu) {
+		c.JSON(http.StatusForbidden, gin.H{"Error": localeFrom(c).Sprintf("error.only-players-may-report-messages")})
+		return
+	}
+
+	l := localeFrom(c)
+	reason := c.PostForm("reason")
+	var r *MessageReport
+	_, err = client.updateModeration(c, g.ID(), func(ml *mlog.MLog, mod *Moderation) error {
+		switch {
+		case i < 0 || i >= len(ml.Messages):
+			return l.VError("error.received-invalid-message")
+		case ml.Messages[i].CreatorID == cu.ID():
+			return l.VError("error.cant-report-own-message")
+		case mod.isDeleted(i) || mod.isHidden(i):
+			return l.VError("error.message-already-been-removed")
+		}
+
+		m := ml.Messages[i]
+		r = &MessageReport{
+			ID:           len(mod.Reports) + 1,
+			MessageIndex: i,
+			AuthorID:     m.CreatorID,
+			AuthorName:   m.CreatorName,
+			Text:         m.Text,
+			ReporterID:   cu.ID(),
+			ReporterName: cu.Name,
+			Reason:       reason,
+			CreatedAt:    time.Now(),
+		}
+		mod.Reports = append(mod.Reports, r)
+		return nil
+	})
+	if sn.IsVError(err) {
+		c.JSON(http.StatusBadRequest, gin.H{"Error": err.Error()})
+		return
+	}
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
+
+// moderate applies f to the moderation of the game, provided the current user is an admin.  The
+// moderation is updated in a transaction, so that concurrent reports are not lost.
+func (client *Client) moderate(f func(*gin.Context, *mlog.MLog, *Moderation) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client.Log.Debugf(msgEnter)
+		defer client.Log.Debugf(msgExit)
+
+		cu, err := client.User.Current(c)
+		if err != nil || !cu.IsAdmin() {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		id, err := getID(c)
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+
+		mod, err := client.updateModeration(c, id, func(ml *mlog.MLog, mod *Moderation) error {
+			return f(c, ml, mod)
+		})
+		if sn.IsVError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"Error": err.Error()})
+			return
+		}
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, mod.ModerationState)
+	}
+}
+
+func (client *Client) hideMessage(hidden bool) gin.HandlerFunc {
+	return client.moderate(func(c *gin.Context, ml *mlog.MLog, mod *Moderation) error {
+		i, err := getMessageIndex(c)
+		if err != nil {
+			return localeFrom(c).VError("error.received-invalid-message")
+		}
+
+		if i < 0 || i >= len(ml.Messages) {
+			return localeFrom(c).VError("error.received-invalid-message")
+		}
+		mod.setHidden(i, hidden)
+		return nil
+	})
+}
+
+func (client *Client) muteUser(muted bool) gin.HandlerFunc {
+	return client.moderate(func(c *gin.Context, _ *mlog.MLog, mod *Moderation) error {
+		uid, err := strconv.ParseInt(c.Param("uid"), 10, 64)
+		if err != nil {
+			return localeFrom(c).VError("error.received-invalid-user")
+		}
+		mod.setMuted(uid, muted)
+		return nil
+	})
+}
+
+func (client *Client) dismissReport() gin.HandlerFunc {
+	return client.moderate(func(c *gin.Context, _ *mlog.MLog, mod *Moderation) error {
+		id, err := strconv.Atoi(c.Param("rid"))
+		if err != nil {
+			return localeFrom(c).VError("error.received-invalid-report")
+		}
+		r := mod.report(id)
+		if r == nil {
+			return localeFrom(c).VError("error.received-invalid-report")
+		}
+		r.Resolved = true
+		return nil
+	})
+}
+
+// moderationJSON provides admins the moderation of a game together with its unmoderated message log.
+func (client *Client) moderationJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	cu, err := client.User.Current(c)
+	if err != nil || !cu.IsAdmin() {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	ml, mod, err := client.messageLogs(c)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Moderation": mod.ModerationState, "Messages": ml.Messages})
+}
+
+// moderationQueue provides admins the unresolved reports of all games.
+func (client *Client) moderationQueue(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	cu, err := client.User.Current(c)
+	if err != nil || !cu.IsAdmin() {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	q := datastore.NewQuery(moderationKind).Ancestor(pk(c)).Filter("Pending >", 0)
+
+	var mods []*Moderation
+	_, err = client.DS.GetAll(c, q, &mods)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	type queued struct {
+		GameID int64
+		*MessageReport
+	}
+
+	rs := []*queued{}
+	for _, mod := range mods {
+		for _, r := range mod.Reports {
+			if !r.Resolved {
+				rs = append(rs, &queued{GameID: mod.Key.ID, MessageReport: r})
+			}
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"Reports": rs})
+}
+
+// muted returns true if the user has been muted in the game.
+func (client *Client) muted(c *gin.Context, g *Game, cu *user.User) bool {
+	if g == nil || cu == nil {
+		return false
+	}
+
+	mod, err := client.getModeration(c, g.ID())
+	if err != nil {
+		client.Log.Warningf(err.Error())
+		return false
+	}
+	return mod.isMuted(cu.ID())
+}
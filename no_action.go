@@ -52,14 +52,22 @@ func (p *Player) newNoActionEntry() *noActionEntry {
 }
 
 func (g *noActionEntry) HTML() template.HTML {
-	return restful.HTML("%s performed no action.", g.Player().Name())
+	return g.locale().HTML("entry.no-action", g.Player().Name())
 }
 
 func (g *Game) EnableNoAction(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.inActionsOrImperialFavourPhase() && g.CurrentPlayer() != nil &&
-		!cp.PerformedAction && g.IsCurrentPlayer(cu) &&
-		cp.hasEnoughCubesFor(NoActionSpace) && cp.hasActionCubes()
+	return g.NoActionReasons(cu).None()
+}
+
+// NoActionReasons returns why the user cu may not take no action.
+func (g *Game) NoActionReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, NoActionSpace).
+		require(cp.hasActionCubes(), "reason.no-action-cubes")
+	return r.reasons
 }
 
 func (p *Player) hasActionCubes() bool {
@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -26,29 +25,35 @@ func (g *Game) nominateStudent(c *gin.Context, cu *user.User) (string, game.Acti
 	}
 
 	cp := g.CurrentPlayer()
-	cp.PerformedAction = true
 
 	// Place Action Cubes
 	cp.PlaceCubesIn(NominateSpace, cbs)
 
+	e := cp.nominateStudent(cds)
+
+	// Set flash message
+	restful.AddNoticef(c, string(e.HTML()))
+	return "", game.Cache, nil
+}
+
+func (p *Player) nominateStudent(cds ConCards) *nominateStudentEntry {
+	g := p.Game()
+	p.PerformedAction = true
+
 	// Move played cards from hand to discard pile
-	cp.ConCardHand.Remove(cds...)
+	p.ConCardHand.Remove(cds...)
 	g.ConDiscardPile.Append(cds...)
 
 	// Place Student
 	can := g.Candidate()
 	if can.hasOnePlayer() {
-		can.setOtherPlayer(cp)
+		can.setOtherPlayer(p)
 	} else {
-		can.setPlayer(cp)
+		can.setPlayer(p)
 	}
 
 	// Create Action Object for logging
-	e := cp.newNominateStudentEntry(cds)
-
-	// Set flash message
-	restful.AddNoticef(c, string(e.HTML()))
-	return "", game.Cache, nil
+	return p.newNominateStudentEntry(cds)
 }
 
 type nominateStudentEntry struct {
@@ -67,9 +72,13 @@ func (p *Player) newNominateStudentEntry(cds ConCards) *nominateStudentEntry {
 }
 
 func (e *nominateStudentEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
-	return restful.HTML("%s spent %d %s having %d coins to nominate student.",
-		e.Player().Name(), length, pluralize("card", length), e.Played.Coins())
+	if length == 0 {
+		return l.HTML("entry.nominate-student", e.Player().Name())
+	}
+	return l.HTML("entry.nominate-student-paid",
+		e.Player().Name(), length, l.Plural("card", length), e.Played.Coins())
 }
 
 func (g *Game) validateNominateStudent(c *gin.Context, cu *user.User) (ConCards, int, error) {
@@ -78,7 +87,7 @@ func (g *Game) validateNominateStudent(c *gin.Context, cu *user.User) (ConCards,
 		return nil, 0, err
 	}
 
-	cds, err := g.getConCards(c, "nominate-student")
+	cds, err := g.getPayment(c, "nominate-student", nominationCost, payCoins)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -88,31 +97,43 @@ func (g *Game) validateNominateStudent(c *gin.Context, cu *user.User) (ConCards,
 	coinValue := cds.Coins()
 	switch {
 	case g.Round == 1:
-		return nil, 0, sn.NewVError("You cannot nominate a student during round %d.", g.Round)
+		return nil, 0, g.vError("error.cannot-nominate-student-during-round", g.Round)
 	case can.hasTwoPlayers():
-		return nil, 0, sn.NewVError("There are already two students.")
+		return nil, 0, g.vError("error.there-already-two-students")
 	case cp.Equal(can.Player()):
 		fallthrough
 	case cp.Equal(can.OtherPlayer()):
-		return nil, 0, sn.NewVError("You already have a nominated student.")
-	case !cp.canAffordNomination():
-		return nil, 0, sn.NewVError("You selected cards having %d total coins, but you need 2 coins to nominate a student.", coinValue)
+		return nil, 0, g.vError("error.already-nominated-student")
+	case coinValue < nominationCost:
+		return nil, 0, g.vError("error.insufficient-coins-nominate", coinValue)
 	}
 	return cds, cbs, nil
 }
 
 func (g *Game) EnableNominateStudent(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	cd := g.Candidate()
-	return g.inActionsOrImperialFavourPhase() && cp != nil && g.IsCurrentPlayer(cu) &&
-		g.Round > 1 && !cp.PerformedAction && cp.hasEnoughCubesFor(NominateSpace) && cd.hasSpaceFor(cp) &&
-		cp.canAffordNomination()
+	return g.NominateStudentReasons(cu).None()
 }
 
-func (p *Player) canAffordNomination() bool {
-	return p.ConCardHand.Coins() >= 2
+// NominateStudentReasons returns why the user cu may not nominate a student.
+func (g *Game) NominateStudentReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().afterFirstRound().notPerformed(cp).cubes(cp, NominateSpace)
+	if cd := g.Candidate(); cd == nil {
+		r.require(false, "reason.no-candidate")
+	} else {
+		r.require(!cd.hasTwoPlayers(), "reason.candidate-full").
+			require(cp.NotEqual(cd.Player()), "reason.already-nominated")
+	}
+	r.coins(cp, nominationCost)
+	return r.reasons
 }
 
+// nominationCost is the cost in coins of nominating a student.
+const nominationCost = 2
+
 func (c *CandidateTile) hasSpaceFor(p *Player) bool {
 	return !c.hasTwoPlayers() && p.NotEqual(c.Player())
 }
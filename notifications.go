@@ -0,0 +1,75 @@
+package confucius
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/restful"
+	"github.com/SlothNinja/send"
+	gtype "github.com/SlothNinja/type"
+	"github.com/gin-gonic/gin"
+	"github.com/mailjet/mailjet-apiv3-go"
+)
+
+// notificationInfo provides the game data used by the shared turn notification template.
+type notificationInfo struct {
+	GameID int64
+	Type   gtype.Type
+	Title  string
+}
+
+// SendTurnNotificationsTo notifies the players that it is their turn, as the game header does,
+// adding a summary of the game since the last turn of each player and a plain text rendering of the
+// board as seen by the player.
+func (g *Game) SendTurnNotificationsTo(c *gin.Context, ps ...*Player) error {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	if len(ps) == 0 {
+		return nil
+	}
+
+	ms := make([]mailjet.InfoMessagesV31, len(ps))
+	for i, p := range ps {
+		msg, err := g.notificationFor(c, p)
+		if err != nil {
+			return err
+		}
+		ms[i] = msg
+	}
+	_, err := send.Messages(c, ms...)
+	return err
+}
+
+func (g *Game) notificationFor(c *gin.Context, p *Player) (mailjet.InfoMessagesV31, error) {
+	buf := new(bytes.Buffer)
+	tmpl := restful.TemplatesFrom(c)["shared/turn_notification"]
+	err := tmpl.Execute(buf, gin.H{"Game": notificationInfo{GameID: g.ID(), Type: g.Type, Title: g.Title}})
+	if err != nil {
+		return mailjet.InfoMessagesV31{}, err
+	}
+
+	summary := g.SummaryFor(p)
+	buf.WriteString(string(g.SummaryHTML(summary)))
+	text := g.RenderText(p.User())
+	if !summary.Empty() {
+		text = g.SummaryText(summary) + "\n" + text
+	}
+
+	return mailjet.InfoMessagesV31{
+		From: &mailjet.RecipientV31{
+			Email: "webmaster@slothninja.com",
+			Name:  "Webmaster",
+		},
+		To: &mailjet.RecipientsV31{
+			mailjet.RecipientV31{
+				Email: g.EmailFor(p),
+				Name:  g.NameFor(p),
+			},
+		},
+		Subject:  fmt.Sprintf("SlothNinja Games: It's your turn in %s (%d)", g.Title, g.ID()),
+		HTMLPart: buf.String(),
+		TextPart: text,
+	}, nil
+}
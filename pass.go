@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -60,7 +59,7 @@ func (p *Player) newPassEntry() *passEntry {
 }
 
 func (e *passEntry) HTML() template.HTML {
-	return restful.HTML("%s passed.", e.Player().Name())
+	return e.locale().HTML("entry.pass", e.Player().Name())
 }
 
 func (p *Player) autoPass() {
@@ -82,7 +81,7 @@ func (p *Player) newAutoPassEntry() *autoPassEntry {
 }
 
 func (e *autoPassEntry) HTML() template.HTML {
-	return restful.HTML("System auto passed for %s.", e.Player().Name())
+	return e.locale().HTML("entry.auto-pass", e.Player().Name())
 }
 
 func (p *Player) validatePass(c *gin.Context, cu *user.User) error {
@@ -91,18 +90,35 @@ func (p *Player) validatePass(c *gin.Context, cu *user.User) error {
 	case err != nil:
 		return err
 	case p.hasActionCubes():
-		return sn.NewVError("You must use all of your action cubes before passing.")
+		return p.Game().vError("error.must-use-all-action-cubes-before")
 	default:
 		return nil
 	}
 }
 
 func (g *Game) EnablePass(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canPass()
+	return g.PassReasons(cu).None()
+}
+
+// PassReasons returns why the user cu may not pass.
+func (g *Game) PassReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	return cp.passReasons()
+}
+
+// passReasons returns why the player may not pass, whoever is asking.
+func (p *Player) passReasons() Reasons {
+	r := &reasonCheck{g: p.Game()}
+	r.phase(Actions).notPerformed(p).
+		require(!p.Passed, "reason.already-passed").
+		require(!p.Game().ExtraAction, "reason.extra-action").
+		require(!p.hasActionCubes(), "reason.has-action-cubes", p.ActionCubes)
+	return r.reasons
 }
 
 func (p *Player) canPass() bool {
-	g := p.Game()
-	return g.Phase == Actions && !p.PerformedAction && !p.Passed && !p.Game().ExtraAction && !p.hasActionCubes()
+	return p.passReasons().None()
 }
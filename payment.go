@@ -0,0 +1,165 @@
+package confucius
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// A paymentMeasure values Confucius cards, by their coins or, as for recruiting armies, by their
+// licenses.
+type paymentMeasure struct {
+	value func(ConCards) int
+	unit  string
+}
+
+var (
+	payCoins    = paymentMeasure{value: ConCards.Coins, unit: "coin"}
+	payLicenses = paymentMeasure{value: ConCards.Licenses, unit: "license"}
+)
+
+// Payment proposes Confucius cards paying a cost, by the number of cards played of each coin value.
+// Overpaid is the value paid in excess of the cost.
+type Payment struct {
+	Coins1   int
+	Coins2   int
+	Coins3   int
+	Value    int
+	Overpaid int
+}
+
+func (p *Payment) cards() ConCards {
+	return ConCards{}.AppendN(1, p.Coins1).AppendN(2, p.Coins2).AppendN(3, p.Coins3)
+}
+
+// payments returns the sensible payments of the cost from the cards, fewest overpaid first, then
+// fewest cards played.  A payment is sensible if it pays the cost and no card played could be kept
+// while still paying it.
+func (cds ConCards) payments(cost int, measure paymentMeasure) []*Payment {
+	var ps []*Payment
+	for c1 := 0; c1 <= cds.Count(1); c1++ {
+		for c2 := 0; c2 <= cds.Count(2); c2++ {
+			for c3 := 0; c3 <= cds.Count(3); c3++ {
+				p := &Payment{Coins1: c1, Coins2: c2, Coins3: c3}
+				cards := p.cards()
+				p.Value = measure.value(cards)
+				if p.Value >= cost && wasted(cards, cost, measure) == nil {
+					p.Overpaid = p.Value - cost
+					ps = append(ps, p)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(ps, func(i, j int) bool {
+		if ps[i].Overpaid != ps[j].Overpaid {
+			return ps[i].Overpaid < ps[j].Overpaid
+		}
+		return len(ps[i].cards()) < len(ps[j].cards())
+	})
+	return ps
+}
+
+// wasted returns a card played that could be kept while still paying the cost, or nil if there is none.
+func wasted(cards ConCards, cost int, measure paymentMeasure) *ConCard {
+	total := measure.value(cards)
+	for _, card := range cards {
+		if total-measure.value(ConCards{card}) >= cost {
+			return card
+		}
+	}
+	return nil
+}
+
+// payments returns the sensible payments of the cost from the hand of the player, fewest overpaid
+// first, without playing the cards to keep.
+func (p *Player) payments(cost int, measure paymentMeasure, keep ConCards) []*Payment {
+	hand := append(ConCards{}, p.ConCardHand...)
+	return hand.RemoveS(keep...).payments(cost, measure)
+}
+
+// getPayment returns the cards played by the current player to pay the cost of the action.  Where
+// the <formValue>-auto-pay form value is set, the cards are chosen from the hand so as to overpay
+// the least, keeping any cards selected by the <formValue>-keep-coins form values.  Otherwise, the
+// cards selected are rejected if some card could be kept while still paying the cost.
+func (g *Game) getPayment(c *gin.Context, formValue string, cost int, measure paymentMeasure) (ConCards, error) {
+	cp := g.CurrentPlayer()
+	l := g.Locale()
+	if autoPay, _ := strconv.ParseBool(c.PostForm(formValue + "-auto-pay")); autoPay {
+		var keep ConCards
+		if c.PostForm(formValue+"-keep-coins1") != "" || c.PostForm(formValue+"-keep-coins2") != "" ||
+			c.PostForm(formValue+"-keep-coins3") != "" {
+			var err error
+			if keep, err = g.getConCards(c, formValue+"-keep"); err != nil {
+				return nil, err
+			}
+		}
+
+		ps := cp.payments(cost, measure, keep)
+		if len(ps) == 0 {
+			return nil, g.vError("error.cannot-auto-pay", cost, l.Plural(measure.unit, cost))
+		}
+		return ps[0].cards(), nil
+	}
+
+	cards, err := g.getConCards(c, formValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if card := wasted(cards, cost, measure); card != nil {
+		value := measure.value(cards)
+		return nil, g.vError("error.overpayment", value, l.Plural(measure.unit, value), cost,
+			card.Coins, l.Plural("coin", card.Coins))
+	}
+	return cards, nil
+}
+
+func (client *Client) paymentsJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Payments": g.paymentsFor(c, cu)})
+}
+
+// paymentsFor returns the sensible payments by the user cu of the cost query value, in licenses if
+// the licenses query value is set, without playing the cards given by the keep query value, such as 1,3.
+func (g *Game) paymentsFor(c *gin.Context, cu *user.User) []*Payment {
+	if cu == nil {
+		return nil
+	}
+
+	p := g.PlayerByUserID(cu.ID())
+	cost, err := strconv.Atoi(c.Query("cost"))
+	if p == nil || err != nil {
+		return nil
+	}
+
+	measure := payCoins
+	if licenses, _ := strconv.ParseBool(c.Query("licenses")); licenses {
+		measure = payLicenses
+	}
+
+	var keep ConCards
+	for _, s := range strings.Split(c.Query("keep"), ",") {
+		if coins, err := strconv.Atoi(strings.TrimSpace(s)); err == nil && coins >= 1 && coins <= 3 {
+			keep = keep.AppendN(coins, 1)
+		}
+	}
+	return p.payments(cost, measure, keep)
+}
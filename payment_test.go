@@ -0,0 +1,184 @@
+package confucius
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestPlayerPayments(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand     []int
+		cost     int
+		measure  paymentMeasure
+		keep     []int
+		want     *Payment
+		payments int
+	}{
+		{"exact", []int{1, 2, 3}, 3, payCoins, nil, &Payment{Coins3: 1, Value: 3}, 2},
+		{"exact with several cards", []int{1, 2, 2}, 4, payCoins, nil, &Payment{Coins2: 2, Value: 4}, 1},
+		{"unavoidable overpayment", []int{3, 3}, 2, payCoins, nil, &Payment{Coins3: 1, Value: 3, Overpaid: 1}, 1},
+		{"cards kept", []int{1, 2, 3}, 3, payCoins, []int{3}, &Payment{Coins1: 1, Coins2: 1, Value: 3}, 1},
+		{"kept cards not in hand", []int{1, 2}, 3, payCoins, []int{3, 3}, &Payment{Coins1: 1, Coins2: 1, Value: 3}, 1},
+		{"every card kept", []int{1, 3, 3}, 3, payCoins, []int{3, 3, 3}, nil, 0},
+		{"licenses of several cards", []int{1, 3, 3}, 2, payLicenses, nil, &Payment{Coins3: 2, Value: 2}, 2},
+		{"licenses of one card", []int{1, 2}, 2, payLicenses, nil, &Payment{Coins2: 1, Value: 2}, 2},
+		{"cost zero", []int{1, 2, 3}, 0, payCoins, nil, &Payment{}, 1},
+		{"unaffordable", []int{1, 1}, 3, payCoins, nil, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 3)
+			cp := g.CurrentPlayer()
+			cp.ConCardHand = conCards(tt.hand)
+
+			ps := cp.payments(tt.cost, tt.measure, conCards(tt.keep))
+			if len(ps) != tt.payments {
+				t.Errorf("payments: got %d, want %d", len(ps), tt.payments)
+			}
+			var got *Payment
+			if len(ps) > 0 {
+				got = ps[0]
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("first payment: got %+v, want %+v", got, tt.want)
+			}
+			if got, want := cp.ConCardHand, conCards(tt.hand); !reflect.DeepEqual(got, want) {
+				t.Errorf("hand: got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestPaymentsOrder(t *testing.T) {
+	ps := conCards([]int{1, 1, 2, 3, 3}).payments(4, payCoins)
+	var got [][3]int
+	for _, p := range ps {
+		got = append(got, [3]int{p.Coins1, p.Coins2, p.Coins3})
+	}
+
+	// Exact payments first, fewest cards first, then overpayments.
+	want := [][3]int{{1, 0, 1}, {2, 1, 0}, {0, 1, 1}, {0, 0, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("payments: got %v, want %v", got, want)
+	}
+}
+
+func TestWasted(t *testing.T) {
+	tests := []struct {
+		name    string
+		cards   []int
+		cost    int
+		measure paymentMeasure
+		want    int
+	}{
+		{"exact", []int{1, 2}, 3, payCoins, 0},
+		{"unavoidable overpayment", []int{3}, 2, payCoins, 0},
+		{"droppable card", []int{1, 3}, 3, payCoins, 1},
+		{"cost zero", []int{1}, 0, payCoins, 1},
+		{"licenses", []int{3, 3}, 2, payLicenses, 0},
+		{"droppable license", []int{1, 3}, 3, payLicenses, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := wasted(conCards(tt.cards), tt.cost, tt.measure)
+			got := 0
+			if card != nil {
+				got = card.Coins
+			}
+			if got != tt.want {
+				t.Errorf("wasted: got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPayment(t *testing.T) {
+	tests := []struct {
+		name    string
+		form    url.Values
+		cost    int
+		measure paymentMeasure
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "exact",
+			form: url.Values{"pay-coins1": {"1"}, "pay-coins2": {"1"}, "pay-coins3": {"0"}},
+			cost: 3, measure: payCoins, want: []int{1, 2},
+		},
+		{
+			name: "unavoidable overpayment",
+			form: url.Values{"pay-coins1": {"0"}, "pay-coins2": {"0"}, "pay-coins3": {"1"}},
+			cost: 2, measure: payCoins, want: []int{3},
+		},
+		{
+			name: "droppable card",
+			form: url.Values{"pay-coins1": {"1"}, "pay-coins2": {"0"}, "pay-coins3": {"1"}},
+			cost: 3, measure: payCoins, wantErr: true,
+		},
+		{
+			name: "droppable card at cost zero",
+			form: url.Values{"pay-coins1": {"1"}, "pay-coins2": {"0"}, "pay-coins3": {"0"}},
+			cost: 0, measure: payCoins, wantErr: true,
+		},
+		{
+			name: "auto",
+			form: url.Values{"pay-auto-pay": {"true"}},
+			cost: 3, measure: payCoins, want: []int{3},
+		},
+		{
+			name: "auto keeping cards",
+			form: url.Values{"pay-auto-pay": {"true"}, "pay-keep-coins1": {"0"}, "pay-keep-coins2": {"0"},
+				"pay-keep-coins3": {"1"}},
+			cost: 3, measure: payCoins, want: []int{1, 2},
+		},
+		{
+			name: "auto keeping cards not in hand",
+			form: url.Values{"pay-auto-pay": {"true"}, "pay-keep-coins1": {"0"}, "pay-keep-coins2": {"0"},
+				"pay-keep-coins3": {"2"}},
+			cost: 3, measure: payCoins, wantErr: true,
+		},
+		{
+			name: "auto by licenses",
+			form: url.Values{"pay-auto-pay": {"true"}},
+			cost: 2, measure: payLicenses, want: []int{2},
+		},
+		{
+			name: "auto at cost zero",
+			form: url.Values{"pay-auto-pay": {"true"}},
+			cost: 0, measure: payCoins, want: []int{},
+		},
+		{
+			name: "auto unaffordable",
+			form: url.Values{"pay-auto-pay": {"true"}},
+			cost: 7, measure: payCoins, wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g := newTestGame(t, 3)
+			g.CurrentPlayer().ConCardHand = conCards([]int{1, 2, 3})
+			postForm(c, tt.form)
+
+			cards, err := g.getPayment(c, "pay", tt.cost, tt.measure)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("error: got %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := []int{}
+			for _, card := range cards {
+				got = append(got, card.Coins)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cards: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
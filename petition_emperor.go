@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -21,9 +20,21 @@ func init() {
 }
 
 func (g *Game) EnablePetitionEmperor(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	requiredCubes := cp.RequiredCubesFor(PetitionSpace)
-	return g.IsCurrentPlayer(cu) && cp.ActionCubes >= requiredCubes && cp.hasPetitionGift() && !g.BasicGame
+	return g.PetitionEmperorReasons(cu).None()
+}
+
+// PetitionEmperorReasons returns why the user cu may not petition the Emperor.
+func (g *Game) PetitionEmperorReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	if g.HasVariant(BasicVariant) {
+		return r.require(false, "reason.basic-game").reasons
+	}
+	r.cubes(cp, PetitionSpace).
+		require(cp.hasPetitionGift(), "reason.no-petition-gift")
+	return r.reasons
 }
 
 func (p *Player) hasPetitionGift() bool {
@@ -116,8 +127,9 @@ func (p *Player) newMoveJunksEntry(j int, fp, tp *Player) *moveJunksEntry {
 
 func (e *moveJunksEntry) HTML() template.HTML {
 	g := e.Game().(*Game)
-	return restful.HTML("%s used value 2 gift (Tile) to petition Emperor and move %d %s from %s to %s.",
-		g.NameByPID(e.PlayerID), e.Junks, pluralize("junk", e.Junks), g.NameByPID(e.FromPlayerID), g.NameByPID(e.ToPlayerID))
+	l := e.locale()
+	return l.HTML("entry.move-junks",
+		g.NameByPID(e.PlayerID), e.Junks, l.Plural("junk", e.Junks), g.NameByPID(e.FromPlayerID), g.NameByPID(e.ToPlayerID))
 }
 
 func (g *Game) validateMoveJunks(c *gin.Context, cu *user.User) (*Player, *Player, int, int, error) {
@@ -132,16 +144,16 @@ func (g *Game) validateMoveJunks(c *gin.Context, cu *user.User) (*Player, *Playe
 	cp := g.CurrentPlayer()
 
 	switch {
-	case g.BasicGame:
-		return nil, nil, 0, 0, sn.NewVError("You cannot petition the emperor in the basic game.")
+	case g.HasVariant(BasicVariant):
+		return nil, nil, 0, 0, g.vError("error.cannot-petition-emperor-basic-game")
 	case cp.GetBoughtGift(Tile) == nil:
-		return nil, nil, 0, 0, sn.NewVError("You don't have a value 2 (Tile) gift with which to petition the Emperor.")
+		return nil, nil, 0, 0, g.vError("error.dont-value-2-tile-gift-petition")
 	case fromPlayer == nil:
-		return nil, nil, 0, 0, sn.NewVError("You must select a player from which to move junks.")
+		return nil, nil, 0, 0, g.vError("error.must-select-player-move-junks-from")
 	case toPlayer == nil:
-		return nil, nil, 0, 0, sn.NewVError("You must select a player to which to move junks.")
+		return nil, nil, 0, 0, g.vError("error.must-select-player-move-junks-to")
 	case !fromPlayer.hasJunks():
-		return nil, nil, 0, 0, sn.NewVError("%s has no junks to move.", g.NameFor(fromPlayer))
+		return nil, nil, 0, 0, g.vError("error.no-junks-move", g.NameFor(fromPlayer))
 	case fromPlayer.Junks == 1:
 		return fromPlayer, toPlayer, 1, cubes, nil
 	}
@@ -194,7 +206,7 @@ func (g *Game) NewReplaceStudentEntry(p *Player) *replaceStudentEntry {
 }
 
 func (e *replaceStudentEntry) HTML() template.HTML {
-	return restful.HTML("%s used value 3 gift (Vase) to petition Emperor and replace student of %s with own student.",
+	return e.locale().HTML("entry.replace-student",
 		e.Player().Name(), e.OtherPlayer().Name())
 }
 
@@ -212,21 +224,21 @@ func (g *Game) validateReplaceStudent(c *gin.Context, cu *user.User) (*Player, i
 
 	switch {
 	case p == nil:
-		return nil, 0, sn.NewVError("Selected player not found.")
-	case g.BasicGame:
-		return nil, 0, sn.NewVError("You cannot petition the emperor in the basic game.")
+		return nil, 0, g.vError("error.selected-player-not-found")
+	case g.HasVariant(BasicVariant):
+		return nil, 0, g.vError("error.cannot-petition-emperor-basic-game")
 	case cp.Equal(p):
-		return nil, 0, sn.NewVError("You did not select a marker of another player.")
+		return nil, 0, g.vError("error.did-not-select-marker-another-player")
 	case g.Candidate().Player() == nil && g.Candidate().OtherPlayer() == nil:
-		return nil, 0, sn.NewVError("There is no student to replace.")
+		return nil, 0, g.vError("error.there-no-student-replace")
 	case cp.GetBoughtGift(Vase) == nil:
-		return nil, 0, sn.NewVError("You don't have a value 3 (Vase) gift with which to petition the Emperor.")
+		return nil, 0, g.vError("error.dont-value-3-vase-gift-petition")
 	case p.Equal(g.Candidate().Player()):
 		return p, cbs, nil
 	case p.Equal(g.Candidate().OtherPlayer()):
 		return p, cbs, nil
 	}
-	return nil, 0, sn.NewVError("Selected player does not have a student.")
+	return nil, 0, g.vError("error.selected-player-does-not-student")
 }
 
 func (g *Game) swapOfficials(c *gin.Context, cu *user.User) (string, game.ActionType, error) {
@@ -279,8 +291,9 @@ func (p *Player) newSwapOfficialsEntry(m1, m2 *Ministry, o1, o2 *OfficialTile) *
 }
 
 func (g *swapOfficialsEntry) HTML() template.HTML {
-	return restful.HTML("%s used value 4 gift (Coat) to swap %s official with %d seniority with %s official with %d seniority.",
-		g.Player().Name(), g.MinistryName1, g.Seniority1, g.MinistryName2, g.Seniority2)
+	l := g.locale()
+	return l.HTML("entry.swap-officials",
+		g.Player().Name(), l.Name(g.MinistryName1), g.Seniority1, l.Name(g.MinistryName2), g.Seniority2)
 }
 
 func (g *Game) validateSwapOfficials(c *gin.Context, cu *user.User) (*Ministry, *Ministry, *OfficialTile, *OfficialTile, int, error) {
@@ -304,20 +317,20 @@ func (g *Game) validateSwapOfficials(c *gin.Context, cu *user.User) (*Ministry,
 
 	cp := g.CurrentPlayer()
 	switch {
-	case g.BasicGame:
-		return nil, nil, nil, nil, 0, sn.NewVError("You cannot petition the emperor in the basic game.")
+	case g.HasVariant(BasicVariant):
+		return nil, nil, nil, nil, 0, g.vError("error.cannot-petition-emperor-basic-game")
 	case ministry1.Resolved || ministry2.Resolved:
-		return nil, nil, nil, nil, 0, sn.NewVError("You selected an official from a resolved ministry.")
+		return nil, nil, nil, nil, 0, g.vError("error.selected-official-resolved-ministry")
 	case cp.GetBoughtGift(Coat) == nil:
-		return nil, nil, nil, nil, 0, sn.NewVError("You don't have a value 4 (Coat) gift with which to petition the Emperor.")
+		return nil, nil, nil, nil, 0, g.vError("error.dont-value-4-coat-gift-petition")
 	case official1.Variant == NoOfficial || official2.Variant == NoOfficial:
-		return nil, nil, nil, nil, 0, sn.NewVError("You selected a space without an official.")
+		return nil, nil, nil, nil, 0, g.vError("error.selected-space-without-official")
 	case official1.NotBribed():
-		return nil, nil, nil, nil, 0, sn.NewVError("You selected an official without a marker.")
+		return nil, nil, nil, nil, 0, g.vError("error.selected-official-without-marker")
 	case official1.Player().NotEqual(cp):
-		return nil, nil, nil, nil, 0, sn.NewVError("You did not select one of your officials to swap.")
+		return nil, nil, nil, nil, 0, g.vError("error.did-not-select-one-officials-swap")
 	case official2.Seniority < official1.Seniority:
-		return nil, nil, nil, nil, 0, sn.NewVError("You selected an official of another player with a higher seniority.")
+		return nil, nil, nil, nil, 0, g.vError("error.selected-official-another-player-higher-seniority")
 	}
 	return ministry1, ministry2, official1, official2, cubes, err
 }
@@ -373,7 +386,8 @@ func (p *Player) newRedeployArmyEntry(fromBox, toBox *ForeignLandBox) *redeployA
 }
 
 func (e *redeployArmyEntry) HTML() template.HTML {
-	return restful.HTML("%s used value 5 gift (Necklace) to redeploy army from %d point box of %s to %d point box of %s.", e.Player().Name(), e.FromBox, e.FromForeignLandName, e.ToBox, e.ToForeignLandName)
+	l := e.locale()
+	return l.HTML("entry.redeploy-army", e.Player().Name(), e.FromBox, l.Name(e.FromForeignLandName), e.ToBox, l.Name(e.ToForeignLandName))
 }
 
 func (g *Game) validateRedeployArmy(c *gin.Context, cu *user.User) (*ForeignLandBox, *ForeignLandBox, int, error) {
@@ -396,22 +410,22 @@ func (g *Game) validateRedeployArmy(c *gin.Context, cu *user.User) (*ForeignLand
 	}
 
 	switch {
-	case g.BasicGame:
-		return nil, nil, 0, sn.NewVError("You cannot petition the emperor in the basic game.")
+	case g.HasVariant(BasicVariant):
+		return nil, nil, 0, g.vError("error.cannot-petition-emperor-basic-game")
 	case g.CurrentPlayer().GetBoughtGift(Necklace) == nil:
-		return nil, nil, 0, sn.NewVError("You don't have a value 5 (Necklace) gift with which to petition the Emperor.")
+		return nil, nil, 0, g.vError("error.dont-value-5-necklace-gift-petition")
 	case fromBox == nil:
-		return nil, nil, 0, sn.NewVError("You must select a land box from which to redeploy an army.")
+		return nil, nil, 0, g.vError("error.must-select-box-redeploy-from")
 	case fromBox.Player() == nil || !fromBox.Player().IsCurrentPlayer():
-		return nil, nil, 0, sn.NewVError("You don't have an army in the selected box.")
+		return nil, nil, 0, g.vError("error.dont-army-selected-box")
 	case fromBox.land.Resolved:
-		return nil, nil, 0, sn.NewVError("You can't redeploy an army from a resolved foreign land tile.")
+		return nil, nil, 0, g.vError("error.cant-redeploy-from-resolved-land")
 	case toBox == nil:
-		return nil, nil, 0, sn.NewVError("You must select a land box to which to redeploy an army.")
+		return nil, nil, 0, g.vError("error.must-select-box-redeploy-to")
 	case toBox.Player() != nil:
-		return nil, nil, 0, sn.NewVError("The selected land box already has an army.")
+		return nil, nil, 0, g.vError("error.selected-land-box-already-army")
 	case toBox.land.Resolved:
-		return nil, nil, 0, sn.NewVError("You can't redeploy an army to a resolved foreign land tile.")
+		return nil, nil, 0, g.vError("error.cant-redeploy-to-resolved-land")
 	}
 
 	return fromBox, toBox, cubes, nil
@@ -470,8 +484,13 @@ func (p *Player) newReplaceInfluenceEntry(m *Ministry, o *OfficialTile, tp *Play
 
 func (e *replaceInfluenceEntry) HTML() template.HTML {
 	g := e.Game().(*Game)
-	return restful.HTML("%s used value 6 gift (Junk) to replace unsecured marker of %s on %s official with %d seniority with a secured marker of %s.",
-		g.NameByPID(e.PlayerID), g.NameByPID(e.FromPlayerID), e.MinistryName, e.Seniority, g.NameByPID(e.ToPlayerID))
+	l := e.locale()
+	return l.HTML("entry.replace-influence",
+		g.NameByPID(e.PlayerID), g.NameByPID(e.FromPlayerID), l.Name(e.MinistryName), e.Seniority, g.NameByPID(e.ToPlayerID))
+}
+
+func (e *replaceInfluenceEntry) influenceChanges() map[int]int {
+	return map[int]int{e.FromPlayerID: -1, e.ToPlayerID: 1}
 }
 
 func (g *Game) validateReplaceInfluence(c *gin.Context, cu *user.User) (*Ministry, *OfficialTile, *Player, int, error) {
@@ -492,20 +511,20 @@ func (g *Game) validateReplaceInfluence(c *gin.Context, cu *user.User) (*Ministr
 	player := g.PlayerBySID(c.PostForm("replace-influence-player"))
 
 	switch {
-	case g.BasicGame:
-		return nil, nil, nil, 0, sn.NewVError("You cannot petition the emperor in the basic game.")
+	case g.HasVariant(BasicVariant):
+		return nil, nil, nil, 0, g.vError("error.cannot-petition-emperor-basic-game")
 	case player == nil:
-		return nil, nil, nil, 0, sn.NewVError("Selected player not found.")
+		return nil, nil, nil, 0, g.vError("error.selected-player-not-found")
 	case ministry.Resolved:
-		return nil, nil, nil, 0, sn.NewVError("You selected an official from a resolved ministry.")
+		return nil, nil, nil, 0, g.vError("error.selected-official-resolved-ministry")
 	case official.Secured:
-		return nil, nil, nil, 0, sn.NewVError("You selected a secured official.")
+		return nil, nil, nil, 0, g.vError("error.selected-secured-official")
 	case cp.GetBoughtGift(Junk) == nil:
-		return nil, nil, nil, 0, sn.NewVError("You don't have a value 6 (Junk) gift with which to petition the Emperor.")
+		return nil, nil, nil, 0, g.vError("error.dont-value-6-junk-gift-petition")
 	case official.Variant == NoOfficial:
-		return nil, nil, nil, 0, sn.NewVError("You selected a space without an official.")
+		return nil, nil, nil, 0, g.vError("error.selected-space-without-official")
 	case official.NotBribed():
-		return nil, nil, nil, 0, sn.NewVError("You selected an official without a marker.")
+		return nil, nil, nil, 0, g.vError("error.selected-official-without-marker")
 	}
 
 	return ministry, official, player, cubes, nil
@@ -0,0 +1,209 @@
+package confucius
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/gin-gonic/gin"
+)
+
+// phaseRule declares a phase of the game flow: the phases that may follow it, whether a current
+// player plays during it, and the hooks run on entering and leaving it.  Guard, where given, must
+// hold for the game to enter the phase, as described by when.
+type phaseRule struct {
+	next  []game.Phase
+	turn  bool
+	guard func(*Game) bool
+	when  string
+	enter func(*Game)
+	exit  func(*Game)
+}
+
+// phaseRules declares the game flow.  Phases without a rule are never entered; their values are
+// kept as saved games store phases by value.
+var phaseRules = map[game.Phase]*phaseRule{
+	NoPhase:   {next: []game.Phase{Setup}},
+	Setup:     {next: []game.Phase{StartGame}},
+	StartGame: {next: []game.Phase{CountGifts}},
+	CountGifts: {
+		next:  []game.Phase{ChooseChiefMinister},
+		guard: func(g *Game) bool { return !g.endGame() },
+		when:  "game continues",
+		enter: (*Game).beginningOfPhaseReset,
+	},
+	ChooseChiefMinister: {next: []game.Phase{Actions}, turn: true},
+	Actions:             {next: []game.Phase{ImperialFavour}, turn: true},
+	ImperialFavour:      {next: []game.Phase{BuildWall}, turn: true},
+	BuildWall: {
+		next:  []game.Phase{ImperialExamination},
+		enter: (*Game).beginningOfPhaseReset,
+	},
+	ImperialExamination: {next: []game.Phase{ExaminationResolution, MinistryResolution}, turn: true},
+	ExaminationResolution: {
+		next:  []game.Phase{MinistryResolution},
+		turn:  true,
+		guard: func(g *Game) bool { return g.Candidate() != nil },
+		when:  "candidate examined",
+		enter: (*Game).beginningOfPhaseReset,
+	},
+	MinistryResolution: {
+		next:  []game.Phase{MinistryResolution, Invasion},
+		turn:  true,
+		enter: (*Game).beginningOfPhaseReset,
+		exit:  (*Game).clearDecision,
+	},
+	Invasion:   {next: []game.Phase{EndOfRound}},
+	EndOfRound: {next: []game.Phase{Discard}},
+	Discard: {
+		next:  []game.Phase{CountGifts, FinalMinistryResolution, EndGameScoring},
+		turn:  true,
+		enter: (*Game).beginningOfPhaseReset,
+	},
+	FinalMinistryResolution: {
+		next:  []game.Phase{FinalMinistryResolution, EndGameScoring},
+		turn:  true,
+		guard: (*Game).endGame,
+		when:  "game ending",
+		enter: (*Game).beginningOfPhaseReset,
+		exit:  (*Game).clearDecision,
+	},
+	EndGameScoring: {
+		next:  []game.Phase{AwardChiefMinister},
+		guard: (*Game).endGame,
+		when:  "game ending",
+	},
+	AwardChiefMinister: {next: []game.Phase{AwardAdmiral}},
+	AwardAdmiral:       {next: []game.Phase{AwardGeneral}},
+	AwardGeneral:       {next: []game.Phase{AnnounceWinners}},
+	AnnounceWinners:    {next: []game.Phase{GameOver}},
+	GameOver:           {enter: (*Game).gameOver},
+}
+
+func (g *Game) gameOver() {
+	g.Status = game.Completed
+	g.emit(&GameEnded{WinnerIDS: append([]int(nil), g.WinnerIDS...)})
+}
+
+func (g *Game) clearDecision() {
+	g.Decision = nil
+}
+
+// checkPhaseChange returns an error if the phase machine does not allow the game to move from the
+// phase to the other.
+func (g *Game) checkPhaseChange(from, to game.Phase) error {
+	r, ok := phaseRules[from]
+	if !ok {
+		return fmt.Errorf("no phase follows %s", PhaseNames[from])
+	}
+
+	if !includePhase(r.next, to) {
+		return fmt.Errorf("%s may not follow %s", PhaseNames[to], PhaseNames[from])
+	}
+
+	if next := phaseRules[to]; next != nil && next.guard != nil && !next.guard(g) {
+		return fmt.Errorf("%s may not follow %s unless %s", PhaseNames[to], PhaseNames[from], next.when)
+	}
+	return nil
+}
+
+func includePhase(phases []game.Phase, phase game.Phase) bool {
+	for _, p := range phases {
+		if p == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// setPhase moves the game to the phase, running the exit hook of the present phase and the entry
+// hook of the new one, and emits PhaseChanged.  Setting the present phase again is no change
+// unless the phase may follow itself.  Changes the phase machine does not allow are refused, leaving
+// the game as it was, so that the action or turn making one fails rather than saves.
+func (g *Game) setPhase(to game.Phase) error {
+	from := g.Phase
+	if r := phaseRules[from]; from == to && (r == nil || !includePhase(r.next, to)) {
+		return nil
+	}
+
+	if err := g.checkPhaseChange(from, to); err != nil {
+		err = fmt.Errorf("game %d: invalid phase change: %s", g.ID(), err)
+		log.Errorf(err.Error())
+		return err
+	}
+
+	if r := phaseRules[from]; r != nil && r.exit != nil {
+		r.exit(g)
+	}
+	g.Phase = to
+	g.emit(&PhaseChanged{From: from})
+	if r := phaseRules[to]; r != nil && r.enter != nil {
+		r.enter(g)
+	}
+	return nil
+}
+
+// isTurnPhase reports whether a current player plays during the phase.
+func isTurnPhase(phase game.Phase) bool {
+	r := phaseRules[phase]
+	return r != nil && r.turn
+}
+
+// PhaseDiagram returns the game flow declared by the phase machine in the DOT language of Graphviz.
+// Phases in which players take turns are drawn as boxes, and guarded changes are labelled.
+func PhaseDiagram() string {
+	var b strings.Builder
+	b.WriteString("digraph confucius {\n")
+	phases := flowOrder()
+	for _, phase := range phases {
+		shape := "ellipse"
+		if isTurnPhase(phase) {
+			shape = "box"
+		}
+		fmt.Fprintf(&b, "\t%q [shape=%s];\n", PhaseNames[phase], shape)
+	}
+
+	for _, phase := range phases {
+		r := phaseRules[phase]
+		if r == nil {
+			continue
+		}
+
+		for _, next := range r.next {
+			fmt.Fprintf(&b, "\t%q -> %q", PhaseNames[phase], PhaseNames[next])
+			if r := phaseRules[next]; r != nil && r.when != "" {
+				fmt.Fprintf(&b, " [label=%q]", r.when)
+			}
+			b.WriteString(";\n")
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// flowOrder returns the phases reachable from NoPhase in the order the game flow first reaches them.
+func flowOrder() []game.Phase {
+	phases := []game.Phase{NoPhase}
+	for i := 0; i < len(phases); i++ {
+		r := phaseRules[phases[i]]
+		if r == nil {
+			continue
+		}
+		for _, next := range r.next {
+			if !includePhase(phases, next) {
+				phases = append(phases, next)
+			}
+		}
+	}
+	return phases
+}
+
+// phaseDiagram serves the game flow as a Graphviz diagram.
+func (client *Client) phaseDiagram(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(PhaseDiagram()))
+}
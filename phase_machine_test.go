@@ -0,0 +1,153 @@
+package confucius
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/sn"
+)
+
+func TestPhaseRules(t *testing.T) {
+	for _, phase := range flowOrder() {
+		r := phaseRules[phase]
+		if r == nil {
+			t.Errorf("%s: reachable but has no rule", PhaseNames[phase])
+			continue
+		}
+		if len(r.next) == 0 && phase != GameOver {
+			t.Errorf("%s: no phase follows", PhaseNames[phase])
+		}
+		if (r.guard == nil) != (r.when == "") {
+			t.Errorf("%s: guard and its description must be given together", PhaseNames[phase])
+		}
+	}
+
+	// Every phase with a rule is part of the game flow.
+	for phase := range phaseRules {
+		if !includePhase(flowOrder(), phase) {
+			t.Errorf("%s: has a rule but is never reached", PhaseNames[phase])
+		}
+	}
+}
+
+func TestCheckPhaseChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to game.Phase
+		endGame  bool
+		wantErr  bool
+	}{
+		{"next phase", Actions, ImperialFavour, false, false},
+		{"one of several next phases", ImperialExamination, MinistryResolution, false, false},
+		{"phase following itself", MinistryResolution, MinistryResolution, false, false},
+		{"skipped phase", Actions, BuildWall, false, true},
+		{"earlier phase", ImperialFavour, Actions, false, true},
+		{"phase without rule", ReturnCubesPhase, Actions, false, true},
+		{"guard holds", Discard, CountGifts, false, false},
+		{"guard fails", Discard, CountGifts, true, true},
+		{"ending guard holds", Discard, EndGameScoring, true, false},
+		{"ending guard fails", Discard, EndGameScoring, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 3)
+			if tt.endGame {
+				g.Wall = 9
+			}
+
+			err := g.checkPhaseChange(tt.from, tt.to)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Errorf("error: got %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetPhase(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	g.Phase = MinistryResolution
+	g.Decision = &ResolutionDecision{PlayerID: 0, OptionIDS: []int{1, 2}}
+
+	// Leaving Ministry Resolution clears the decision awaited.
+	if err := g.setPhase(Invasion); err != nil {
+		t.Fatal(err)
+	}
+	if g.Phase != Invasion || g.Decision != nil {
+		t.Errorf("got phase %s and decision %+v, want Invasion and none", g.PhaseName(), g.Decision)
+	}
+
+	// Setting the present phase again is no change.
+	events := len(g.events)
+	if err := g.setPhase(Invasion); err != nil {
+		t.Fatal(err)
+	}
+	if g.Phase != Invasion || len(g.events) != events {
+		t.Errorf("got phase %s and %d events, want Invasion and %d", g.PhaseName(), len(g.events), events)
+	}
+
+	// Changes the phase machine does not allow are refused, leaving the game as it was.
+	if err := g.setPhase(Actions); err == nil {
+		t.Error("invalid phase change: got no error")
+	}
+	if g.Phase != Invasion || len(g.events) != events {
+		t.Errorf("got phase %s and %d events, want Invasion and %d", g.PhaseName(), len(g.events), events)
+	}
+}
+
+// TestInvalidPhaseChangeFailsTurn checks that a turn leading to a change the phase machine does not
+// allow fails, so that it is not saved, and that its events are dropped.
+func TestInvalidPhaseChangeFailsTurn(t *testing.T) {
+	client := &Client{Client: &sn.Client{Log: new(log.Logger)}}
+	c, g := newTestGame(t, 3)
+	cp := g.CurrentPlayer()
+	for _, p := range g.Players() {
+		p.Passed = true
+	}
+	cp.PerformedAction = true
+	events := len(g.events)
+
+	// A flaw of the table refusing Imperial Favour, which follows once every player has passed.
+	rule := phaseRules[ImperialFavour]
+	defer func() { phaseRules[ImperialFavour] = rule }()
+	flawed := *rule
+	flawed.guard, flawed.when = func(*Game) bool { return false }, "never"
+	phaseRules[ImperialFavour] = &flawed
+
+	if _, _, err := client.finishTurn(c, g, g.Users[cp.ID()]); err == nil {
+		t.Fatal("finish turn: got no error")
+	}
+	if g.Phase != Actions || len(g.events) != events {
+		t.Errorf("got phase %s and %d events, want Actions and %d", g.PhaseName(), len(g.events), events)
+	}
+}
+
+func TestPhaseDiagram(t *testing.T) {
+	d := PhaseDiagram()
+	if !strings.HasPrefix(d, "digraph confucius {\n") || !strings.HasSuffix(d, "}\n") {
+		t.Fatalf("not a digraph:\n%s", d)
+	}
+
+	for _, want := range []string{
+		`"Actions" [shape=box];`,
+		`"Invasion" [shape=ellipse];`,
+		`"Actions" -> "Imperial Favour";`,
+		`"Ministry Resolution" -> "Ministry Resolution";`,
+		`"Discard" -> "Count Gifts" [label="game continues"];`,
+		`"Discard" -> "End Game Scoring" [label="game ending"];`,
+	} {
+		if !strings.Contains(d, want) {
+			t.Errorf("diagram does not contain %s", want)
+		}
+	}
+
+	edges := 0
+	for _, r := range phaseRules {
+		edges += len(r.next)
+	}
+	if got := strings.Count(d, " -> "); got != edges {
+		t.Errorf("edges: got %d, want %d", got, edges)
+	}
+}
@@ -70,6 +70,7 @@ var PhaseNames = game.PhaseNameMap{
 	AwaitPlayerInput:        "Awaiting Player Input",
 }
 
+// PhaseName returns the name of the present phase in the language of the game.
 func (g *Game) PhaseName() string {
-	return PhaseNames[g.Phase]
+	return g.Locale().Name(PhaseNames[g.Phase])
 }
@@ -2,13 +2,11 @@ package confucius
 
 import (
 	"encoding/gob"
-	"fmt"
 	"html/template"
 
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -26,15 +24,27 @@ func (g *Game) placeStudent(c *gin.Context, cu *user.User) (string, game.ActionT
 		return "", game.None, err
 	}
 
+	cp := g.CurrentPlayer()
+	entry := cp.placeStudentIn(ministry, seniority)
+
+	// Set flash message
+	restful.AddNoticef(c, string(entry.HTML()))
+	return "", game.Cache, nil
+}
+
+// placeStudentIn places the promoted candidate in the seniority spot of the ministry.
+// A nil ministry indicates the student could not be placed.
+func (p *Player) placeStudentIn(ministry *Ministry, seniority Seniority) *placeStudentEntry {
+	g := p.Game()
+
 	var replacedOfficial *OfficialTile
 	if ministry != nil {
 		replacedOfficial = ministry.Officials[seniority]
 	}
-	cp := g.CurrentPlayer()
-	cp.PerformedAction = true
+	p.PerformedAction = true
 
 	// Create Action Object for logging
-	entry := cp.newPlaceStudentEntry()
+	entry := p.newPlaceStudentEntry()
 	if ministry != nil {
 		entry.MinistryName = ministry.Name()
 	} else {
@@ -59,7 +69,7 @@ func (g *Game) placeStudent(c *gin.Context, cu *user.User) (string, game.ActionT
 
 	// Place Secured Marker on Student
 	if official != nil {
-		official.setPlayer(cp)
+		official.setPlayer(p)
 		official.Secured = true
 	}
 
@@ -73,10 +83,7 @@ func (g *Game) placeStudent(c *gin.Context, cu *user.User) (string, game.ActionT
 
 	// Display Back
 	g.Candidates[0] = tileBack
-
-	// Set flash message
-	restful.AddNoticef(c, string(entry.HTML()))
-	return "", game.Cache, nil
+	return entry
 }
 
 type placeStudentEntry struct {
@@ -95,16 +102,28 @@ func (p *Player) newPlaceStudentEntry() *placeStudentEntry {
 }
 
 func (e *placeStudentEntry) HTML() template.HTML {
+	l := e.locale()
 	if e.MinistryName == "None" {
-		return template.HTML(fmt.Sprintf("%s was unable to place student.", e.Player().Name()))
+		return l.HTML("entry.place-student-unable", e.Player().Name())
 	}
 	if e.OtherPlayer() == nil {
-		return template.HTML(fmt.Sprintf("%s placed student in seniority spot %d of %s ministry.",
-			e.Player().Name(), e.Seniority, e.MinistryName))
+		return l.HTML("entry.place-student",
+			e.Player().Name(), e.Seniority, l.Name(e.MinistryName))
 	}
 
-	return template.HTML(fmt.Sprintf("%s placed student in seniority spot %d of %s ministry replacing official of %s.",
-		e.Player().Name(), e.Seniority, e.MinistryName, e.OtherPlayer().Name()))
+	return l.HTML("entry.place-student-replace",
+		e.Player().Name(), e.Seniority, l.Name(e.MinistryName), e.OtherPlayer().Name())
+}
+
+func (e *placeStudentEntry) influenceChanges() map[int]int {
+	if e.MinistryName == "None" {
+		return nil
+	}
+	changes := map[int]int{e.PlayerID: 1}
+	if e.OtherPlayerID != NoPlayerID {
+		changes[e.OtherPlayerID]--
+	}
+	return changes
 }
 
 func (g *Game) validatePlaceStudent(c *gin.Context, cu *user.User) (*Ministry, Seniority, error) {
@@ -117,33 +136,43 @@ func (g *Game) validatePlaceStudent(c *gin.Context, cu *user.User) (*Ministry, S
 	}
 
 	if !g.IsCurrentPlayer(cu) {
-		return nil, 0, sn.NewVError("Only the current player may place a student in a ministry.")
+		return nil, 0, g.vError("error.only-current-player-may-place-student")
 	}
 
 	if g.Phase != ExaminationResolution {
-		return nil, 0, sn.NewVError("You cannot place a student in a ministry during the %s phase.", g.Phase)
+		return nil, 0, g.vError("error.cannot-place-student-ministry-during-phase", g.PhaseName())
 	}
 
 	if !g.MinistriesFor(g.Candidate()).Include(m) {
-		return nil, 0, sn.NewVError("You cannot place a student in ministry %s.", m.Name())
+		return nil, 0, g.vError("error.cannot-place-student-ministry", m.Name())
 	}
 
 	if spots := m.emptyCandidateSpots(); len(spots) > 0 {
 		if !spots.Include(s) {
-			return nil, 0, sn.NewVError("You cannot place a student in seniority spot %d of ministry %s.", s, m.Name())
+			return nil, 0, g.vError("error.cannot-place-student-seniority-spot-ministry", s, m.Name())
 		}
 		return m, s, nil
 	}
 
 	if !m.unbribedUnsecuredCandidateSpots().Include(s) {
-		return nil, 0, sn.NewVError("You cannot place a student in seniority spot %d of ministry %s.", s, m.Name())
+		return nil, 0, g.vError("error.cannot-place-student-seniority-spot-ministry", s, m.Name())
 	}
 
 	return m, s, nil
 }
 
 func (g *Game) EnablePlaceStudent(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.Phase == ExaminationResolution && !g.CurrentPlayer().PerformedAction
+	return g.PlaceStudentReasons(cu).None()
+}
+
+// PlaceStudentReasons returns why the user cu may not place a student.
+func (g *Game) PlaceStudentReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.phase(ExaminationResolution).notPerformed(cp)
+	return r.reasons
 }
 
 func (g *Game) MinistriesFor(c *CandidateTile) Ministries {
@@ -32,6 +32,9 @@ type Player struct {
 	GiftsBought     GiftCards
 	GiftsReceived   GiftCards
 	EmperorHand     EmperorCards
+	ScoreChanges    []*ScoreChange
+	LastSeen        int // Index of the first game log entry following the last turn of the player
+	PendingVoyages  int // Voyages completed whose distant lands the player has yet to choose
 }
 
 type Players []*Player
@@ -142,7 +145,7 @@ func (client *Client) determinePlaces(c *gin.Context, g *Game) ([]contest.Result
 	g.setPlayers(players)
 
 	places := make([]contest.ResultsMap, 0)
-	if g.AdmiralVariant {
+	if g.HasVariant(AdmiralVariant) {
 		winner := g.Players()[0]
 		if g.Players()[0].Score == g.Players()[1].Score {
 			// Admiral win.  Find Admiral and place at g.Players[0]
@@ -173,7 +176,7 @@ func (client *Client) determinePlaces(c *gin.Context, g *Game) ([]contest.Result
 			}
 			switch c := p1.compare(p2); {
 			case i == j:
-			case i == 0 && g.AdmiralVariant:
+			case i == 0 && g.HasVariant(AdmiralVariant):
 				result.Outcome = 1
 				results = append(results, result)
 			case c == game.EqualTo:
@@ -379,17 +382,19 @@ func (p *Player) junkCostFor(j int) int {
 	discountedJunks := []int{0, 1, 2, 4, 7}
 	normalJunks := []int{0, 1, 3, 6, 10}
 
+	cost := normalJunks[j]
 	if p.HasInfluenceIn(p.Game().Ministries[Gongbu]) {
-		return discountedJunks[j]
+		cost = discountedJunks[j]
 	}
-	return normalJunks[j]
+	return p.Game().variantJunkCostFor(p, j, cost)
 }
 
 func (p *Player) armyCost() int {
+	cost := 6
 	if p.HasInfluenceIn(p.Game().Ministries[Bingbu]) {
-		return 4
+		cost = 4
 	}
-	return 6
+	return p.Game().variantArmyCost(p, cost)
 }
 
 func (p *Player) giftFrom(player *Player) *GiftCard {
@@ -516,6 +521,9 @@ func (p *Player) hasLicenses() bool {
 }
 
 func (g *Game) Color(p *Player, cu *user.User) color.Color {
+	if p.isNeutral() {
+		return neutralColor
+	}
 	uid := g.UserIDS[p.ID()]
 	cm := g.ColorMapFor(cu)
 	return cm[int(uid)]
@@ -2,7 +2,6 @@ package confucius
 
 import (
 	"github.com/SlothNinja/log"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -24,13 +23,13 @@ func (g *Game) validatePlayerAction(c *gin.Context, cu *user.User) (int, error)
 	a, cp := c.PostForm("action"), g.CurrentPlayer()
 	switch {
 	case !g.IsCurrentPlayer(cu):
-		return 0, sn.NewVError("Only the current player may perform the player action %q.", a)
+		return 0, g.vError("error.only-current-player-may-perform-player", a)
 	case (a == "pass" || IsEmperorRewardAction(a)) && g.Phase != Actions:
-		return 0, sn.NewVError("You cannot perform a %q action during the %s phase.", a, g.PhaseName())
+		return 0, g.vError("error.cannot-perform-action-during-phase", a, g.PhaseName())
 	case !g.inActionsOrImperialFavourPhase():
-		return 0, sn.NewVError("You cannot perform a %q action during the %s phase.", a, g.PhaseName())
+		return 0, g.vError("error.cannot-perform-action-during-phase", a, g.PhaseName())
 	case cp.Passed:
-		return 0, sn.NewVError("You cannot perform a player action after passing.")
+		return 0, g.vError("error.cannot-perform-player-action-after-passing")
 	default:
 		return cbs, nil
 	}
@@ -40,7 +39,7 @@ func (g *Game) validatePlaceCubesFor(id SpaceID, cu *user.User) (int, error) {
 	cp := g.CurrentPlayer()
 	cbs := cp.RequiredCubesFor(id)
 	if !cp.hasEnoughCubesFor(id) {
-		return 0, sn.NewVError("You must have at least %d Action Cubes to perform this action.", cbs)
+		return 0, g.vError("error.must-at-least-action-cubes-perform", cbs)
 	}
 	return cbs, nil
 }
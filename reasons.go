@@ -0,0 +1,125 @@
+package confucius
+
+import (
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/user"
+)
+
+// Reasons lists why an action is unavailable to a user.  The action is available where there are none.
+type Reasons []string
+
+// None reports whether there are no reasons, so that the action is available.
+func (rs Reasons) None() bool {
+	return len(rs) == 0
+}
+
+// reasonCheck accumulates the reasons an action is unavailable, in the language of the game.
+type reasonCheck struct {
+	g       *Game
+	reasons Reasons
+}
+
+// require adds the reason given by the message id and arguments unless ok.
+func (r *reasonCheck) require(ok bool, id string, args ...interface{}) *reasonCheck {
+	if !ok {
+		r.reasons = append(r.reasons, r.g.Locale().Sprintf(id, args...))
+	}
+	return r
+}
+
+// checkTurn starts checking an action of the user cu, returning the current player, or nil with the
+// reason if the user is not the current player, in which case no other reason applies.
+func (g *Game) checkTurn(cu *user.User) (*Player, *reasonCheck) {
+	r := &reasonCheck{g: g}
+	cp := g.CurrentPlayer()
+	if cp == nil || !g.IsCurrentPlayer(cu) {
+		r.require(false, "reason.not-your-turn")
+		return nil, r
+	}
+	return cp, r
+}
+
+func (r *reasonCheck) phase(phases ...game.Phase) *reasonCheck {
+	for _, phase := range phases {
+		if r.g.Phase == phase {
+			return r
+		}
+	}
+	return r.require(false, "reason.wrong-phase", r.g.PhaseName())
+}
+
+func (r *reasonCheck) actionPhase() *reasonCheck {
+	return r.phase(Actions, ImperialFavour)
+}
+
+func (r *reasonCheck) notPerformed(p *Player) *reasonCheck {
+	return r.require(!p.PerformedAction, "reason.already-performed-action")
+}
+
+func (r *reasonCheck) cubes(p *Player, id SpaceID) *reasonCheck {
+	required := p.RequiredCubesFor(id)
+	return r.require(p.ActionCubes >= required, "reason.not-enough-cubes", required, p.ActionCubes)
+}
+
+func (r *reasonCheck) afterFirstRound() *reasonCheck {
+	return r.require(r.g.Round > 1, "reason.first-round")
+}
+
+func (r *reasonCheck) coins(p *Player, cost int) *reasonCheck {
+	coins := p.ConCardHand.Coins()
+	return r.require(coins >= cost, "reason.insufficient-coins", cost, coins)
+}
+
+func (r *reasonCheck) licenses(p *Player, cost int) *reasonCheck {
+	licenses := p.ConCardHand.Licenses()
+	return r.require(licenses >= cost, "reason.insufficient-licenses", cost, licenses)
+}
+
+// UnavailableAction lists the reasons an action is unavailable.
+type UnavailableAction struct {
+	Action  string
+	Reasons Reasons
+}
+
+// UnavailableActions returns the actions presently unavailable to the user cu and why, or nil if
+// the user is not the current player.
+func (g *Game) UnavailableActions(cu *user.User) []*UnavailableAction {
+	if cp, _ := g.checkTurn(cu); cp == nil {
+		return nil
+	}
+
+	var as []*UnavailableAction
+	for _, a := range []struct {
+		action  string
+		reasons func(*user.User) Reasons
+	}{
+		{"bribe-official", g.BribeOfficialReasons},
+		{"secure-official", g.SecureOfficialReasons},
+		{"nominate-student", g.NominateStudentReasons},
+		{"force-exam", g.ForceExamReasons},
+		{"buy-junks", g.BuyJunksReasons},
+		{"start-voyage", g.StartVoyageReasons},
+		{"choose-distant-land", g.ChooseDistantLandReasons},
+		{"recruit-army", g.RecruitArmyReasons},
+		{"invade-land", g.InvadeLandReasons},
+		{"buy-gift", g.BuyGiftReasons},
+		{"give-gift", g.GiveGiftReasons},
+		{"commercial", g.CommercialReasons},
+		{"tax-income", g.TaxIncomeReasons},
+		{"no-action", g.NoActionReasons},
+		{"petition-emperor", g.PetitionEmperorReasons},
+		{"emperor-reward", g.EmperorRewardReasons},
+		{"transfer-influence", g.TransferInfluenceReasons},
+		{"temp-transfer-influence", g.TempTransferReasons},
+		{"pass", g.PassReasons},
+		{"place-student", g.PlaceStudentReasons},
+		{"discard", g.DiscardReasons},
+		{"choose-chief-minister", g.ChooseChiefMinisterReasons},
+		{"tutor-student", g.TutorStudentReasons},
+	} {
+		if rs := a.reasons(cu); !rs.None() {
+			as = append(as, &UnavailableAction{Action: a.action, Reasons: rs})
+		}
+	}
+	return as
+}
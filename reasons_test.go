@@ -0,0 +1,52 @@
+package confucius
+
+import (
+	"testing"
+
+	"github.com/SlothNinja/game"
+)
+
+func TestPassReasons(t *testing.T) {
+	tests := []struct {
+		name   string
+		phase  game.Phase
+		cubes  int
+		passed bool
+		extra  bool
+		want   bool
+	}{
+		{"may pass", Actions, 0, false, false, true},
+		{"action cubes left", Actions, 1, false, false, false},
+		{"already passed", Actions, 0, true, false, false},
+		{"extra action", Actions, 0, false, true, false},
+		{"wrong phase", ImperialFavour, 0, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 3)
+			cp := g.CurrentPlayer()
+			g.Phase, g.ExtraAction = tt.phase, tt.extra
+			cp.ActionCubes, cp.Passed = tt.cubes, tt.passed
+
+			if got := g.PassReasons(g.Users[cp.ID()]).None(); got != tt.want {
+				t.Errorf("PassReasons none: got %v, want %v", got, tt.want)
+			}
+			if got := cp.canPass(); got != tt.want {
+				t.Errorf("canPass: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGiveGiftReasonsAnyPhase(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	cp := g.CurrentPlayer()
+	g.Phase = MinistryResolution
+	cp.ActionCubes = cp.RequiredCubesFor(GiveGiftSpace)
+	cp.GiftsBought = GiftCards{{Value: 1}}
+
+	if rs := g.GiveGiftReasons(g.Users[cp.ID()]); !rs.None() {
+		t.Errorf("reasons: got %v, want none", rs)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -65,7 +64,7 @@ func (p *Player) newRecruitArmyEntry(c ConCards) *recruitArmyEntry {
 }
 
 func (e *recruitArmyEntry) HTML() template.HTML {
-	return restful.HTML("%s spent %d Confucius cards having %d licenses to recruit army.",
+	return e.locale().HTML("entry.recruit-army",
 		e.Player().Name(), len(e.Played), e.Played.Licenses())
 }
 
@@ -75,35 +74,36 @@ func (g *Game) validateRecruitArmy(c *gin.Context, cu *user.User) (ConCards, int
 		return nil, 0, err
 	}
 
-	cards, err := g.getConCards(c, "recruit-army")
+	cp := g.CurrentPlayer()
+	cards, err := g.getPayment(c, "recruit-army", cp.armyCost(), payLicenses)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	cp := g.CurrentPlayer()
 	switch {
 	case cards.Licenses() < cp.armyCost():
-		return nil, 0, sn.NewVError("You selected cards having %d total licenses, but you need %d licenses to recruit and army.", cards.Licenses(), cp.armyCost())
+		return nil, 0, g.vError("error.insufficient-licenses-recruit", cards.Licenses(), cp.armyCost())
 	case !cp.hasArmies():
-		return nil, 0, sn.NewVError("You have no armies to recruit.")
+		return nil, 0, g.vError("error.no-armies-recruit")
 	}
 
 	return cards, cubes, nil
 }
 
 func (g *Game) EnableRecruitArmy(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canRecruitAnArmy()
+	return g.RecruitArmyReasons(cu).None()
 }
 
-func (p *Player) canRecruitAnArmy() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction && p.hasEnoughCubesFor(RecruitArmySpace) &&
-		p.hasArmies() && p.canAffordAnArmy()
-}
-
-func (p *Player) canAffordAnArmy() bool {
-	return p.ConCardHand.Licenses() >= p.armyCost()
+// RecruitArmyReasons returns why the user cu may not recruit an army.
+func (g *Game) RecruitArmyReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, RecruitArmySpace).
+		require(cp.hasArmies(), "reason.no-armies").
+		licenses(cp, cp.armyCost())
+	return r.reasons
 }
 
 func (p *Player) hasArmies() bool {
@@ -0,0 +1,302 @@
+package confucius
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+// textBoard accumulates the lines of a plain text rendering of the board.  Sections are separated
+// by a blank line and their lines are indented, so the structure survives screen readers, terminals
+// and emails alike.
+type textBoard struct {
+	strings.Builder
+}
+
+func (tb *textBoard) section(heading string) {
+	if tb.Len() > 0 {
+		tb.WriteString("\n")
+	}
+	tb.WriteString(heading + "\n")
+}
+
+func (tb *textBoard) line(depth int, s string) {
+	tb.WriteString(strings.Repeat("  ", depth) + s + "\n")
+}
+
+// RenderText describes the board as seen by the user cu in structured plain text.  Information
+// hidden from the user, such as the Confucius cards of other players, is reported by count only.
+func (g *Game) RenderText(cu *user.User) string {
+	l := g.Locale()
+	tb := new(textBoard)
+
+	tb.section(l.Sprintf("text.game", g.ID(), g.Title))
+	tb.line(1, l.Sprintf("text.round-phase", g.Round, g.PhaseName()))
+	if cp := g.CurrentPlayer(); cp != nil && g.Status != game.Completed {
+		tb.line(1, l.Sprintf("text.current-player", g.NameFor(cp)))
+	}
+	if prompt := g.ResolutionPrompt(); prompt != "" {
+		tb.line(1, prompt)
+	}
+	tb.line(1, l.Sprintf("text.wall", g.Wall))
+	tb.line(1, l.Sprintf("text.junks-in-stock", g.Junks, l.Plural("junk", g.Junks)))
+
+	tb.section(l.Sprintf("text.titles"))
+	tb.line(1, l.Sprintf("text.chief-minister", g.textName(g.ChiefMinister())))
+	tb.line(1, l.Sprintf("text.admiral", g.textName(g.Admiral())))
+	tb.line(1, l.Sprintf("text.general", g.textName(g.General())))
+	tb.line(1, l.Sprintf("text.avenger", g.textName(g.Avenger())))
+
+	g.renderMinistries(tb)
+	g.renderCandidate(tb, cu)
+	g.renderForeignLands(tb)
+	g.renderDistantLands(tb)
+	g.renderDecks(tb)
+	for _, p := range g.Players() {
+		g.renderPlayer(tb, p, cu)
+	}
+	return tb.String()
+}
+
+// textName returns the name of the player, or none where there is no player.
+func (g *Game) textName(p *Player) string {
+	if p == nil {
+		return g.Locale().Sprintf("text.none")
+	}
+	return g.NameFor(p)
+}
+
+// reveals reports whether hidden information of the player may be shown to the user cu.
+func (g *Game) reveals(p *Player, cu *user.User) bool {
+	return g.Status == game.Completed || (cu != nil && g.PlayerByUserID(cu.ID()).Equal(p))
+}
+
+func (g *Game) renderMinistries(tb *textBoard) {
+	l := g.Locale()
+	tb.section(l.Sprintf("text.ministries"))
+	for _, id := range ministeryIDS {
+		m, ok := g.Ministries[id]
+		if !ok {
+			continue
+		}
+
+		s := l.Sprintf("text.ministry", l.Name(m.Name()), m.MinisterChit.Value(), m.SecretaryChit.Value())
+		switch {
+		case m.Resolved:
+			s += " " + l.Sprintf("text.resolved")
+		case m.InProgress:
+			s += " " + l.Sprintf("text.in-progress")
+		}
+		tb.line(1, s)
+
+		if minister := m.Minister(); minister != nil {
+			tb.line(2, l.Sprintf("text.minister", g.NameFor(minister)))
+		}
+		if secretary := m.Secretary(); secretary != nil {
+			tb.line(2, l.Sprintf("text.secretary", g.NameFor(secretary)))
+		}
+
+		seniorities := make(Seniorities, 0, len(m.Officials))
+		for seniority := range m.Officials {
+			seniorities = append(seniorities, seniority)
+		}
+		sort.Slice(seniorities, func(i, j int) bool { return seniorities[i] < seniorities[j] })
+
+		for _, seniority := range seniorities {
+			o := m.Officials[seniority]
+			var marker string
+			switch p := o.Player(); {
+			case p == nil:
+				marker = l.Sprintf("text.no-marker")
+			case o.Secured:
+				marker = l.Sprintf("text.secured-marker", g.NameFor(p))
+			default:
+				marker = l.Sprintf("text.marker", g.NameFor(p))
+			}
+			if temp := o.TempPlayer(); temp != nil {
+				marker += "; " + l.Sprintf("text.temp-marker", g.NameFor(temp))
+			}
+			tb.line(2, l.Sprintf("text.official", o.Seniority, o.Cost, marker))
+		}
+	}
+}
+
+func (g *Game) renderCandidate(tb *textBoard, cu *user.User) {
+	l := g.Locale()
+	tb.section(l.Sprintf("text.candidates"))
+
+	remaining := len(g.Candidates)
+	c := g.Candidate()
+	if c != nil && c.Variant != TileBack {
+		var names []string
+		for _, id := range ministeryIDS {
+			if _, ok := g.MinistriesFor(c)[id]; ok {
+				names = append(names, l.Name(g.Ministries[id].Name()))
+			}
+		}
+		tb.line(1, l.Sprintf("text.candidate", l.ToSentence(names)))
+
+		for _, student := range []struct {
+			p     *Player
+			cards ConCards
+		}{{c.Player(), c.PlayerCards}, {c.OtherPlayer(), c.OtherPlayerCards}} {
+			if student.p == nil {
+				continue
+			}
+			tb.line(2, l.Sprintf("text.student", g.NameFor(student.p), g.textCards(student.p, student.cards, cu)))
+		}
+		remaining--
+	}
+	tb.line(1, l.Sprintf("text.candidates-remaining", remaining))
+}
+
+// textCards describes Confucius cards of the player: their coins, if the user cu may see them,
+// and otherwise their number.
+func (g *Game) textCards(p *Player, cards ConCards, cu *user.User) string {
+	l := g.Locale()
+	if !g.reveals(p, cu) {
+		return l.Sprintf("text.cards", len(cards), l.Plural("card", len(cards)))
+	}
+
+	coins := make([]string, len(cards))
+	for i, card := range cards {
+		coins[i] = strconv.Itoa(card.Coins)
+	}
+	return l.Sprintf("text.cards-coins", len(cards), l.Plural("card", len(cards)), strings.Join(coins, ", "),
+		cards.Coins(), l.Plural("coin", cards.Coins()))
+}
+
+func (g *Game) renderForeignLands(tb *textBoard) {
+	l := g.Locale()
+	if len(g.ForeignLands) == 0 {
+		return
+	}
+
+	tb.section(l.Sprintf("text.foreign-lands"))
+	for _, land := range g.ForeignLands {
+		s := l.Sprintf("text.foreign-land", l.Name(land.Name()), land.Cost())
+		if land.Resolved {
+			s += " " + l.Sprintf("text.resolved")
+		}
+		tb.line(1, s)
+
+		for i, box := range land.Boxes {
+			id := "text.box"
+			if box.AwardCard {
+				id = "text.box-award-card"
+			}
+			tb.line(2, l.Sprintf(id, i+1, box.Points, l.Plural("point", box.Points), g.textName(box.Player())))
+		}
+	}
+}
+
+func (g *Game) renderDistantLands(tb *textBoard) {
+	l := g.Locale()
+	if len(g.DistantLands) == 0 {
+		return
+	}
+
+	tb.section(l.Sprintf("text.distant-lands"))
+	for _, land := range g.DistantLands {
+		var chit string
+		if land.Chit == NoChit {
+			chit = l.Sprintf("text.no-chit")
+		} else {
+			chit = l.Sprintf("text.chit", land.Chit.Value(), l.Plural("point", land.Chit.Value()))
+		}
+
+		var names []string
+		for _, p := range land.Players() {
+			names = append(names, g.NameFor(p))
+		}
+		visitors := l.Sprintf("text.not-visited")
+		if len(names) > 0 {
+			visitors = l.Sprintf("text.visited-by", l.ToSentence(names))
+		}
+		tb.line(1, l.Sprintf("text.distant-land", l.Name(land.Name()), chit, visitors))
+	}
+}
+
+func (g *Game) renderDecks(tb *textBoard) {
+	l := g.Locale()
+	info := g.DeckInfo()
+	tb.section(l.Sprintf("text.decks"))
+	tb.line(1, l.Sprintf("text.con-deck", info.ConCardsLeft, l.Plural("card", info.ConCardsLeft)))
+	for _, coins := range coinValues {
+		tb.line(2, l.Sprintf("text.con-seen", coins, info.ConCardsSeen[coins], info.ConCardsUnseen[coins],
+			info.ConDiscardPile[coins]))
+	}
+
+	discarded := l.Sprintf("text.none")
+	if len(info.EmperorDiscard) > 0 {
+		discarded = strings.Join(info.EmperorDiscard, ", ")
+	}
+	tb.line(1, l.Sprintf("text.emperor-deck", info.EmperorCardsLeft, l.Plural("card", info.EmperorCardsLeft), discarded))
+	tb.line(1, l.Sprintf("text.officials-deck", info.OfficialsLeft))
+}
+
+func (g *Game) renderPlayer(tb *textBoard, p *Player, cu *user.User) {
+	l := g.Locale()
+	tb.section(l.Sprintf("text.player", g.NameFor(p), p.Score, l.Plural("point", p.Score)))
+	tb.line(1, l.Sprintf("text.action-cubes", p.ActionCubes))
+	tb.line(1, l.Sprintf("text.junks", p.Junks, p.OnVoyage))
+	tb.line(1, l.Sprintf("text.armies", p.Armies, p.RecruitedArmies))
+	tb.line(1, l.Sprintf("text.confucius-cards", g.textCards(p, p.ConCardHand, cu)))
+	tb.line(1, l.Sprintf("text.gift-hand", g.textGifts(p.GiftCardHand, false)))
+	tb.line(1, l.Sprintf("text.gifts-received", g.textGifts(p.GiftsReceived, true)))
+
+	// Emperor's Reward cards are never shown publicly while held.  Revealed only records that their
+	// holder has seen them, so it does not make them visible to other players.
+	var titles []string
+	if g.reveals(p, cu) {
+		for _, card := range p.EmperorHand {
+			titles = append(titles, l.Name(card.Title()))
+		}
+	} else if hidden := len(p.EmperorHand); hidden > 0 {
+		titles = append(titles, l.Sprintf("text.hidden-cards", hidden, l.Plural("card", hidden)))
+	}
+	if len(titles) == 0 {
+		titles = append(titles, l.Sprintf("text.none"))
+	}
+	tb.line(1, l.Sprintf("text.emperor-cards", strings.Join(titles, ", ")))
+}
+
+// textGifts names the gifts, and optionally the players by whom they were given.
+func (g *Game) textGifts(gifts GiftCards, givers bool) string {
+	l := g.Locale()
+	if len(gifts) == 0 {
+		return l.Sprintf("text.none")
+	}
+
+	names := make([]string, len(gifts))
+	for i, gift := range gifts {
+		names[i] = l.Name(gift.Name())
+		if p := gift.Player(); givers && p != nil {
+			names[i] = l.Sprintf("text.gift-from", names[i], g.NameFor(p))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func (client *Client) showText(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil {
+		client.Log.Debugf(err.Error())
+	}
+
+	c.String(http.StatusOK, g.RenderText(cu))
+}
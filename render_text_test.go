@@ -0,0 +1,31 @@
+package confucius
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderTextEmperorCards(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	holder, opponent := g.Players()[0], g.Players()[1]
+	card := &EmperorCard{Type: EmperorInsulted, Revealed: true}
+	holder.EmperorHand = EmperorCards{card}
+
+	tests := []struct {
+		name  string
+		text  string
+		shown bool
+	}{
+		{"holder", g.RenderText(g.Users[holder.ID()]), true},
+		{"opponent", g.RenderText(g.Users[opponent.ID()]), false},
+		{"logged out", g.RenderText(nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if shown := strings.Contains(tt.text, card.Title()); shown != tt.shown {
+				t.Errorf("%s shown: got %v, want %v", card.Title(), shown, tt.shown)
+			}
+		})
+	}
+}
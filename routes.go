@@ -15,6 +15,8 @@ type Client struct {
 	Game   *game.Client
 	MLog   *mlog.Client
 	Rating *rating.Client
+
+	subscribers []Subscriber
 }
 
 func NewClient(snClient *sn.Client, uClient *user.Client, gClient *game.Client, rClient *rating.Client, t gtype.Type) *Client {
@@ -25,12 +27,13 @@ func NewClient(snClient *sn.Client, uClient *user.Client, gClient *game.Client,
 		MLog:   mlog.NewClient(snClient, uClient),
 		Rating: rClient,
 	}
+	client.Subscribe(client.logEvent)
 	return client.register(t)
 }
 
 func (client *Client) addRoutes(prefix string) *Client {
 	// Game group
-	g := client.Router.Group(prefix + "/game")
+	g := client.Router.Group(prefix+"/game", client.userLocale)
 
 	// New
 	g.GET("/new",
@@ -49,6 +52,120 @@ func (client *Client) addRoutes(prefix string) *Client {
 		client.show(prefix),
 	)
 
+	// JSON Data for Score Breakdown
+	g.GET("/show/:hid/scores/json",
+		client.fetch,
+		client.scoresJSON,
+	)
+
+	// JSON Data for Title Standings
+	g.GET("/show/:hid/titles/json",
+		client.fetch,
+		client.titlesJSON,
+	)
+
+	// JSON Data for Ministry Forecasts
+	g.GET("/show/:hid/forecast/json",
+		client.fetch,
+		client.forecastJSON,
+	)
+
+	// Plain Text Board
+	g.GET("/show/:hid/text",
+		client.fetch,
+		game.SetAdmin(false),
+		client.showText,
+	)
+
+	// Board Drawing
+	g.GET("/show/:hid/board/svg",
+		client.fetch,
+		client.boardSVG,
+	)
+
+	g.GET("/show/:hid/board/png",
+		client.fetch,
+		client.boardPNG,
+	)
+
+	// JSON Data for Decks
+	g.GET("/show/:hid/decks/json",
+		client.fetch,
+		client.decksJSON,
+	)
+
+	// JSON Data for Payments
+	g.GET("/show/:hid/payments/json",
+		client.fetch,
+		client.paymentsJSON,
+	)
+
+	// JSON Data for Legal Actions
+	g.GET("/show/:hid/actions/json",
+		client.fetch,
+		client.actionsJSON,
+	)
+
+	// Gift Obligation Graph
+	g.GET("/show/:hid/gifts/json",
+		client.fetch,
+		client.giftGraphJSON,
+	)
+
+	g.GET("/show/:hid/gifts/svg",
+		client.fetch,
+		client.giftGraphSVG,
+	)
+
+	// Edit Message
+	g.PUT("/show/:hid/message/:mid",
+		client.fetch,
+		client.editMessage,
+	)
+
+	// Delete Message
+	g.DELETE("/show/:hid/message/:mid",
+		client.fetch,
+		client.deleteMessage,
+	)
+
+	// Report Message
+	g.POST("/show/:hid/message/:mid/report",
+		client.fetch,
+		client.reportMessage,
+	)
+
+	// Private Diplomacy Channels
+	g.GET("/show/:hid/diplomacy/json",
+		client.fetch,
+		client.diplomacyJSON,
+	)
+
+	g.POST("/show/:hid/diplomacy",
+		client.fetch,
+		client.createChannel,
+	)
+
+	g.GET("/show/:hid/channel/:cid/json",
+		client.fetch,
+		client.channelJSON,
+	)
+
+	g.PUT("/show/:hid/channel/:cid/addmessage",
+		client.fetch,
+		client.addChannelMessage,
+	)
+
+	g.PUT("/show/:hid/channel/:cid/message/:mid",
+		client.fetch,
+		client.editChannelMessage,
+	)
+
+	g.DELETE("/show/:hid/channel/:cid/message/:mid",
+		client.fetch,
+		client.deleteChannelMessage,
+	)
+
 	// Undo
 	g.POST("/undo/:hid",
 		client.fetch,
@@ -89,7 +206,7 @@ func (client *Client) addRoutes(prefix string) *Client {
 	)
 
 	// Games group
-	gs := client.Router.Group(prefix + "/games")
+	gs := client.Router.Group(prefix+"/games", client.userLocale)
 
 	// Index
 	gs.GET("/:status",
@@ -102,6 +219,72 @@ func (client *Client) addRoutes(prefix string) *Client {
 		client.jsonIndexAction(prefix),
 	)
 
+	// Solo group
+	solo := client.Router.Group(prefix+"/solo", client.userLocale)
+
+	// Results
+	solo.GET("/:uid/json",
+		client.soloResults,
+	)
+
+	// Turns group
+	turns := client.Router.Group(prefix+"/turns", client.userLocale)
+
+	// Games Awaiting Turn
+	turns.GET("/json",
+		client.turnsJSON,
+	)
+
+	// Stalled group
+	stalled := client.Router.Group(prefix+"/stalled", client.userLocale)
+
+	// JSON Data for Stalled Games
+	stalled.GET("/json",
+		client.stalledJSON,
+	)
+
+	// Phases group
+	phases := client.Router.Group(prefix+"/phases", client.userLocale)
+
+	// Graphviz Diagram of the Game Flow
+	phases.GET("/dot",
+		client.phaseDiagram,
+	)
+
+	// Moderation group
+	moderation := client.Router.Group(prefix+"/moderation", client.userLocale)
+
+	// Queue
+	moderation.GET("/queue/json",
+		client.moderationQueue,
+	)
+
+	// Locale group
+	locale := client.Router.Group(prefix+"/locale", client.userLocale)
+
+	// Select Locale
+	locale.POST("",
+		client.setLocale,
+	)
+
+	// Tournament group
+	t := client.Router.Group(prefix+"/tournament", client.userLocale)
+
+	// Create
+	t.POST("",
+		client.createTournament(prefix),
+	)
+
+	// JSON Data for Standings
+	t.GET("/:tid/json",
+		client.showTournament,
+	)
+
+	// Report
+	t.GET("/:tid/report",
+		client.tournamentReport,
+	)
+
 	// Admin group
 	admin := g.Group("/admin")
 
@@ -118,6 +301,57 @@ func (client *Client) addRoutes(prefix string) *Client {
 		client.endRound(prefix),
 	)
 
+	// Advance Stalled Game
+	admin.POST("/:hid/advance",
+		client.fetch,
+		client.advance(prefix),
+	)
+
+	// Make Awaited Decision By Default
+	admin.POST("/:hid/decide-default",
+		client.fetch,
+		client.decideDefault(prefix),
+	)
+
+	// Moderate Diplomacy Channels
+	admin.GET("/:hid/diplomacy/json",
+		client.fetch,
+		client.moderateDiplomacy,
+	)
+
+	// Message Moderation
+	admin.GET("/:hid/moderation/json",
+		client.moderationJSON,
+	)
+
+	admin.POST("/:hid/message/:mid/hide",
+		client.hideMessage(true),
+	)
+
+	admin.POST("/:hid/message/:mid/unhide",
+		client.hideMessage(false),
+	)
+
+	admin.POST("/:hid/channel/:cid/message/:mid/hide",
+		client.hideChannelMessage(true),
+	)
+
+	admin.POST("/:hid/channel/:cid/message/:mid/unhide",
+		client.hideChannelMessage(false),
+	)
+
+	admin.POST("/:hid/mute/:uid",
+		client.muteUser(true),
+	)
+
+	admin.POST("/:hid/unmute/:uid",
+		client.muteUser(false),
+	)
+
+	admin.POST("/:hid/report/:rid/dismiss",
+		client.dismissReport(),
+	)
+
 	// Admin Update
 	admin.POST("/:hid",
 		client.fetch,
@@ -0,0 +1,144 @@
+package confucius
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ScoreSource identifies the rule by which a player scored points.
+type ScoreSource int
+
+const (
+	UnrecordedScore ScoreSource = iota
+	MinisterScore
+	SecretaryScore
+	VoyageScore
+	InvasionScore
+	AvengerScore
+	ChiefMinisterTitleScore
+	AdmiralTitleScore
+	GeneralTitleScore
+	AdminScore
+)
+
+var scoreSources = []ScoreSource{
+	MinisterScore,
+	SecretaryScore,
+	VoyageScore,
+	InvasionScore,
+	AvengerScore,
+	ChiefMinisterTitleScore,
+	AdmiralTitleScore,
+	GeneralTitleScore,
+	AdminScore,
+	UnrecordedScore,
+}
+
+var scoreSourceStrings = map[ScoreSource]string{
+	UnrecordedScore:         "Unrecorded",
+	MinisterScore:           "Minister",
+	SecretaryScore:          "Secretary",
+	VoyageScore:             "Voyages",
+	InvasionScore:           "Invasions",
+	AvengerScore:            "Avenging the Emperor",
+	ChiefMinisterTitleScore: "Chief Minister Title",
+	AdmiralTitleScore:       "Admiral Title",
+	GeneralTitleScore:       "General Title",
+	AdminScore:              "Admin Adjustment",
+}
+
+func (s ScoreSource) String() string {
+	return scoreSourceStrings[s]
+}
+
+// ScoreChange records points scored by a player.  Changes are recorded before the log entry
+// reporting them is appended, so LogIndex is the index of that entry in the game log.
+type ScoreChange struct {
+	Source   ScoreSource
+	Points   int
+	Round    int
+	LogIndex int
+}
+
+// addScore adds points to the score of the player and records the change.
+func (p *Player) addScore(source ScoreSource, points int) {
+	g := p.Game()
+	p.Score += points
+	p.ScoreChanges = append(p.ScoreChanges, &ScoreChange{
+		Source:   source,
+		Points:   points,
+		Round:    g.Round,
+		LogIndex: len(g.Log),
+	})
+}
+
+// ScoreSubtotal provides the points a player scored from a single source.
+type ScoreSubtotal struct {
+	Source string
+	Points int
+}
+
+// ScoreBreakdown returns the points scored by the player from each source, in source order.
+// Points not accounted for by recorded changes, e.g., those of games started before changes were
+// recorded, are reported as unrecorded.
+func (p *Player) ScoreBreakdown() []*ScoreSubtotal {
+	totals := make(map[ScoreSource]int)
+	recorded := 0
+	for _, change := range p.ScoreChanges {
+		totals[change.Source] += change.Points
+		recorded += change.Points
+	}
+	totals[UnrecordedScore] += p.Score - recorded
+
+	var subtotals []*ScoreSubtotal
+	for _, source := range scoreSources {
+		if points := totals[source]; points != 0 {
+			subtotals = append(subtotals, &ScoreSubtotal{Source: source.String(), Points: points})
+		}
+	}
+	return subtotals
+}
+
+// ScoreBreakdownString returns the score breakdown of the player on a single line.
+func (p *Player) ScoreBreakdownString() string {
+	var ss []string
+	for _, subtotal := range p.ScoreBreakdown() {
+		ss = append(ss, fmt.Sprintf("%s %d", subtotal.Source, subtotal.Points))
+	}
+	if len(ss) == 0 {
+		return "no points"
+	}
+	return strings.Join(ss, ", ")
+}
+
+func (client *Client) scoresJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	type playerScores struct {
+		Name      string
+		Score     int
+		Breakdown []*ScoreSubtotal
+		Changes   []*ScoreChange
+	}
+
+	ps := make([]*playerScores, len(g.Players()))
+	for i, p := range g.Players() {
+		ps[i] = &playerScores{
+			Name:      g.NameFor(p),
+			Score:     p.Score,
+			Breakdown: p.ScoreBreakdown(),
+			Changes:   p.ScoreChanges,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"Players": ps})
+}
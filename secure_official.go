@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -65,9 +64,10 @@ func (p *Player) newSecureOfficialEntry(m *Ministry, o *OfficialTile, c ConCards
 }
 
 func (e *secureOfficialEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
-	return restful.HTML("%s spent %d %s having %d coins to secure %s official having level %d seniority.",
-		e.Player().Name(), length, pluralize("card", length), e.Played.Coins(), e.MinistryName, e.Seniority)
+	return l.HTML("entry.secure-official",
+		e.Player().Name(), length, l.Plural("card", length), e.Played.Coins(), l.Name(e.MinistryName), e.Seniority)
 }
 
 func (g *Game) validateSecureOfficial(c *gin.Context, cu *user.User) (ConCards, *Ministry, *OfficialTile, int, error) {
@@ -76,66 +76,52 @@ func (g *Game) validateSecureOfficial(c *gin.Context, cu *user.User) (ConCards,
 		return nil, nil, nil, 0, err
 	}
 
-	cards, err := g.getConCards(c, "secure-official")
+	ministry, official, err := g.getMinistryAndOfficial(c, "secure-official")
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
 
-	ministry, official, err := g.getMinistryAndOfficial(c, "secure-official")
+	cp := g.CurrentPlayer()
+	cost := cp.CostFor(official)
+	cards, err := g.getPayment(c, "secure-official", cost, payCoins)
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
-
-	cp := g.CurrentPlayer()
 	coinValue := cards.Coins()
-	cost := cp.CostFor(official)
 
 	switch {
 	case official.Player() == nil:
-		return nil, nil, nil, 0, sn.NewVError("You must select an official with a marker.")
+		return nil, nil, nil, 0, g.vError("error.must-select-official-marker")
 	case official.Player().NotEqual(cp):
-		return nil, nil, nil, 0, sn.NewVError("You must have a marker on the official before securing it.")
+		return nil, nil, nil, 0, g.vError("error.must-marker-official-before-securing-it")
 	case official.Secured:
-		return nil, nil, nil, 0, sn.NewVError("You must select an official without a secured marker.")
+		return nil, nil, nil, 0, g.vError("error.must-select-official-without-secured-marker")
 	case coinValue < cost:
-		return nil, nil, nil, 0, sn.NewVError("You selected cards having %d total coins, but you need %d coins to secure the selected official.", coinValue, cost)
+		return nil, nil, nil, 0, g.vError("error.insufficient-coins-secure", coinValue, cost)
 	}
 
 	return cards, ministry, official, cubes, nil
 }
 
 func (g *Game) EnableSecureOfficial(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canSecureAnOfficial()
+	return g.SecureOfficialReasons(cu).None()
 }
 
-func (p *Player) canSecureAnOfficial() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction && p.hasEnoughCubesFor(BribeSecureSpace) &&
-		g.hasSecurableOfficialFor(p)
-}
-
-func (g *Game) hasSecurableOfficialFor(p *Player) bool {
-	for _, m := range g.Ministries {
-		if !m.Resolved {
-			for _, o := range m.Officials {
-				if p.canSecure(o) {
-					return true
-				}
-			}
-		}
+// SecureOfficialReasons returns why the user cu may not secure an official.
+func (g *Game) SecureOfficialReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
 	}
-	return false
-}
-
-func (p *Player) canSecure(o *OfficialTile) bool {
-	return !o.Secured && p.hasBribed(o) && p.canAffordToSecure(o)
+	r.actionPhase().notPerformed(cp).cubes(cp, BribeSecureSpace)
+	if cost, ok := g.cheapestOfficialFor(cp, func(o *OfficialTile) bool { return !o.Secured && cp.hasBribed(o) }); ok {
+		r.coins(cp, cost)
+	} else {
+		r.require(false, "reason.no-securable-official")
+	}
+	return r.reasons
 }
 
 func (p *Player) hasBribed(o *OfficialTile) bool {
 	return p.Equal(o.Player())
 }
-
-func (p *Player) canAffordToSecure(o *OfficialTile) bool {
-	return p.canAffordToBribe(o)
-}
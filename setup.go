@@ -0,0 +1,188 @@
+package confucius
+
+import (
+	"github.com/SlothNinja/sn"
+)
+
+// Limits applied to random draws by the balanced setup.
+const (
+	balancedMinDistantLandPoints = 15
+	balancedMaxDistantLandPoints = 17
+	balancedMaxMinistrySpread    = 2
+)
+
+// SetupOptions fix parts of the otherwise random setup, e.g., for tournaments and teaching games.
+// DistantLandChits are given in the order of the distant lands.  MinistryChits are given in pairs,
+// in the order of the ministries, the higher chit of each pair going to the minister.
+// Balanced limits extreme distributions of the chits that are drawn at random.
+type SetupOptions struct {
+	ForeignLands     []ForeignLandID
+	DistantLandChits DistantLandChits
+	MinistryChits    MinistryChits
+	Balanced         bool
+}
+
+func newDistantLandChits() DistantLandChits {
+	return DistantLandChits{2, 2, 3, 3, 4, 4, 4}
+}
+
+func newMinistryChits() MinistryChits {
+	return MinistryChits{4, 4, 5, 5, 6, 6, 7, 7, 8, 8}
+}
+
+func (cs DistantLandChits) ints() []int {
+	is := make([]int, len(cs))
+	for i, c := range cs {
+		is[i] = int(c)
+	}
+	return is
+}
+
+func (mcs MinistryChits) ints() []int {
+	is := make([]int, len(mcs))
+	for i, mc := range mcs {
+		is[i] = int(mc)
+	}
+	return is
+}
+
+// drawnFrom returns true if each value of is can be drawn from pool.
+func drawnFrom(is, pool []int) bool {
+	counts := make(map[int]int)
+	for _, i := range pool {
+		counts[i] += 1
+	}
+	for _, i := range is {
+		if counts[i] == 0 {
+			return false
+		}
+		counts[i] -= 1
+	}
+	return true
+}
+
+func setupFromForm(lands, distantChits, ministryChits []int, balanced bool) SetupOptions {
+	var opts SetupOptions
+	for _, id := range lands {
+		opts.ForeignLands = append(opts.ForeignLands, ForeignLandID(id))
+	}
+	for _, chit := range distantChits {
+		opts.DistantLandChits = append(opts.DistantLandChits, DistantLandChit(chit))
+	}
+	for _, chit := range ministryChits {
+		opts.MinistryChits = append(opts.MinistryChits, MinistryChit(chit))
+	}
+	opts.Balanced = balanced
+	return opts
+}
+
+func (g *Game) validateSetup() error {
+	opts := g.Setup
+
+	if l := len(opts.ForeignLands); l > 0 {
+		if l != 3 {
+			return g.vError("error.must-select-exactly-3-foreign-lands")
+		}
+		for i, id := range opts.ForeignLands {
+			if _, ok := foreignLandIDStrings[id]; !ok {
+				return g.vError("error.received-invalid-foreign-land")
+			}
+			for _, other := range opts.ForeignLands[:i] {
+				if other == id {
+					return g.vError("error.can-not-select-more-than-once", id)
+				}
+			}
+		}
+	}
+
+	if l := len(opts.DistantLandChits); l > 0 {
+		if l != len(distanLandIDS) {
+			return g.vError("error.must-provide-chit-each-distant-lands", len(distanLandIDS))
+		}
+		if !drawnFrom(opts.DistantLandChits.ints(), newDistantLandChits().ints()) {
+			return g.vError("error.distant-land-chits-must-drawn", newDistantLandChits())
+		}
+	}
+
+	if l := len(opts.MinistryChits); l > 0 {
+		if l != 2*len(ministeryIDS) {
+			return g.vError("error.must-provide-2-chits-each-ministries", len(ministeryIDS))
+		}
+		if !drawnFrom(opts.MinistryChits.ints(), newMinistryChits().ints()) {
+			return g.vError("error.ministry-chits-must-drawn", newMinistryChits())
+		}
+	}
+	return nil
+}
+
+func (cs DistantLandChits) points() int {
+	points := 0
+	for _, chit := range cs {
+		points += chit.Value()
+	}
+	return points
+}
+
+func (cs DistantLandChits) balanced() bool {
+	points := cs.points()
+	return points >= balancedMinDistantLandPoints && points <= balancedMaxDistantLandPoints
+}
+
+// drawDistantLandChits draws a chit for each distant land, redrawing until balanced, if required.
+func (g *Game) drawDistantLandChits() DistantLandChits {
+	if len(g.Setup.DistantLandChits) == len(distanLandIDS) {
+		return g.Setup.DistantLandChits
+	}
+
+	for {
+		pool := newDistantLandChits()
+		chits := make(DistantLandChits, len(distanLandIDS))
+		for i := range chits {
+			chits[i] = pool.Draw()
+		}
+		if !g.Setup.Balanced || chits.balanced() {
+			return chits
+		}
+	}
+}
+
+// Draw removes and returns a random chit.
+func (mcs *MinistryChits) Draw() MinistryChit {
+	i := sn.MyRand.Intn(len(*mcs))
+	chit := (*mcs)[i]
+	*mcs = append((*mcs)[:i], (*mcs)[i+1:]...)
+	return chit
+}
+
+// balanced returns true if the totals of the chit pairs differ by no more than balancedMaxMinistrySpread.
+func (mcs MinistryChits) balanced() bool {
+	low, high := -1, -1
+	for i := 0; i+1 < len(mcs); i += 2 {
+		total := mcs[i].Value() + mcs[i+1].Value()
+		if low == -1 || total < low {
+			low = total
+		}
+		if high == -1 || total > high {
+			high = total
+		}
+	}
+	return high-low <= balancedMaxMinistrySpread
+}
+
+// drawMinistryChits draws a pair of chits for each ministry, redrawing until balanced, if required.
+func (g *Game) drawMinistryChits() MinistryChits {
+	if len(g.Setup.MinistryChits) == 2*len(ministeryIDS) {
+		return g.Setup.MinistryChits
+	}
+
+	for {
+		pool := newMinistryChits()
+		chits := make(MinistryChits, 2*len(ministeryIDS))
+		for i := range chits {
+			chits[i] = pool.Draw()
+		}
+		if !g.Setup.Balanced || chits.balanced() {
+			return chits
+		}
+	}
+}
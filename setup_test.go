@@ -0,0 +1,117 @@
+package confucius
+
+import "testing"
+
+func TestCreateFixedForeignLands(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	ids := []ForeignLandID{Manchuria, Annam, Korea}
+	g.Setup.ForeignLands = ids
+
+	g.CreateForeignLands()
+
+	if got := len(g.ForeignLands); got != len(ids) {
+		t.Fatalf("lands: got %d, want %d", got, len(ids))
+	}
+	for i, land := range g.ForeignLands {
+		if land.ID != ids[i] {
+			t.Errorf("land %d: got %s, want %s", i, land.ID, ids[i])
+		}
+		want := ids[i].CreateBoxes(land)
+		if len(land.Boxes) != len(want) {
+			t.Errorf("%s: got %d boxes, want %d", land.ID, len(land.Boxes), len(want))
+		}
+		for _, box := range land.Boxes {
+			if box.land != land {
+				t.Errorf("%s: box %d belongs to another land", land.ID, box.Position)
+			}
+		}
+	}
+}
+
+func TestCreateRandomForeignLands(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	g.CreateForeignLands()
+
+	if got := len(g.ForeignLands); got != 3 {
+		t.Fatalf("lands: got %d, want 3", got)
+	}
+	seen := make(map[ForeignLandID]bool)
+	for _, land := range g.ForeignLands {
+		if seen[land.ID] {
+			t.Errorf("%s selected more than once", land.ID)
+		}
+		seen[land.ID] = true
+	}
+}
+
+func TestPresetChits(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	g.Setup.DistantLandChits = DistantLandChits{4, 3, 2, 4, 3}
+	g.Setup.MinistryChits = MinistryChits{4, 8, 5, 7, 6, 6}
+
+	g.CreateDistantLands()
+	g.setMinistryChits()
+
+	for i, id := range distanLandIDS {
+		if got, want := g.DistantLands[id].Chit, g.Setup.DistantLandChits[i]; got != want {
+			t.Errorf("%v: chit %d, want %d", id, got, want)
+		}
+	}
+	for i, id := range ministeryIDS {
+		m := g.Ministries[id]
+		low, high := g.Setup.MinistryChits[2*i], g.Setup.MinistryChits[2*i+1]
+		if low > high {
+			low, high = high, low
+		}
+		if m.MinisterChit != high || m.SecretaryChit != low {
+			t.Errorf("%s: got chits %d and %d, want %d and %d", m.Name(), m.MinisterChit, m.SecretaryChit, high, low)
+		}
+	}
+}
+
+func TestBalancedChits(t *testing.T) {
+	_, g := newTestGame(t, 3)
+	g.Setup.Balanced = true
+
+	// The draws are random, so repeat them to exercise the redraws.
+	for i := 0; i < 50; i++ {
+		dcs := g.drawDistantLandChits()
+		if !dcs.balanced() {
+			t.Fatalf("distant land chits %v worth %d points", dcs, dcs.points())
+		}
+		if !drawnFrom(dcs.ints(), newDistantLandChits().ints()) {
+			t.Fatalf("distant land chits %v not drawn from the pool", dcs)
+		}
+
+		mcs := g.drawMinistryChits()
+		if !mcs.balanced() {
+			t.Fatalf("ministry chits %v not balanced", mcs)
+		}
+		if !drawnFrom(mcs.ints(), newMinistryChits().ints()) {
+			t.Fatalf("ministry chits %v not drawn from the pool", mcs)
+		}
+	}
+}
+
+func TestChitsBalanced(t *testing.T) {
+	tests := []struct {
+		name string
+		dcs  DistantLandChits
+		mcs  MinistryChits
+		want bool
+	}{
+		{"balanced", DistantLandChits{2, 3, 3, 4, 4}, MinistryChits{4, 8, 5, 7, 6, 6}, true},
+		{"extreme", DistantLandChits{2, 2, 3, 3, 4}, MinistryChits{4, 4, 5, 5, 8, 8}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dcs.balanced(); got != tt.want {
+				t.Errorf("distant land chits %v balanced: got %v, want %v", tt.dcs, got, tt.want)
+			}
+			if got := tt.mcs.balanced(); got != tt.want {
+				t.Errorf("ministry chits %v balanced: got %v, want %v", tt.mcs, got, tt.want)
+			}
+		})
+	}
+}
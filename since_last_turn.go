@@ -0,0 +1,230 @@
+package confucius
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"regexp"
+	"strings"
+
+	"github.com/SlothNinja/user"
+)
+
+// An influenceChanger is a log entry that moved bribery markers between players.  It reports the
+// number of markers each player, identified by id, gained or lost.
+type influenceChanger interface {
+	influenceChanges() map[int]int
+}
+
+// An obligationChanger is a log entry that created or canceled gift obligations.
+type obligationChanger interface {
+	obligationChanges() []*obligationChange
+}
+
+// obligationChange records that the debtor came to owe, or no longer owes, the creditor for a gift.
+type obligationChange struct {
+	debtorID   int
+	creditorID int
+	gift       string
+	canceled   bool
+}
+
+// SummaryGroup lists the log entries of a player, or of the game where PlayerID is NoPlayerID.
+type SummaryGroup struct {
+	PlayerID int
+	Name     string
+	Entries  []template.HTML
+}
+
+// TurnSummary reports what happened in the game since the last turn of a player, and how it
+// affected the player.
+type TurnSummary struct {
+	Groups      []*SummaryGroup
+	Score       int
+	Sources     []*ScoreSubtotal
+	Influence   int
+	Obligations []string
+}
+
+// Empty reports whether nothing happened since the last turn of the player.
+func (s *TurnSummary) Empty() bool {
+	return s == nil || len(s.Groups) == 0
+}
+
+// lastSeen returns the index of the first log entry the player has not seen.  Games started before
+// markers were kept fall back to the entry following the last one of the player.
+func (p *Player) lastSeen() int {
+	g := p.Game()
+	if p.LastSeen > 0 && p.LastSeen <= len(g.Log) {
+		return p.LastSeen
+	}
+	for i := len(g.Log) - 1; i >= 0; i-- {
+		if pl := g.Log[i].Player(); pl != nil && pl.ID() == p.ID() {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// markSeen records that the player has seen the log entries preceding index i.
+func (p *Player) markSeen(i int) {
+	p.LastSeen = i
+}
+
+// SummaryFor summarises the log entries following the last turn of the player.
+func (g *Game) SummaryFor(p *Player) *TurnSummary {
+	if p == nil {
+		return nil
+	}
+
+	l := g.Locale()
+	start := p.lastSeen()
+	s := new(TurnSummary)
+	groups := make(map[int]*SummaryGroup)
+	for _, e := range g.Log[start:] {
+		pid := NoPlayerID
+		if pl := e.Player(); pl != nil {
+			pid = pl.ID()
+		}
+
+		group, ok := groups[pid]
+		if !ok {
+			group = &SummaryGroup{PlayerID: pid, Name: l.Sprintf("summary.game")}
+			if pid != NoPlayerID {
+				group.Name = g.NameByPID(pid)
+			}
+			groups[pid] = group
+			s.Groups = append(s.Groups, group)
+		}
+		group.Entries = append(group.Entries, e.HTML())
+
+		if ic, ok := e.(influenceChanger); ok {
+			s.Influence += ic.influenceChanges()[p.ID()]
+		}
+		if oc, ok := e.(obligationChanger); ok {
+			for _, change := range oc.obligationChanges() {
+				if msg := g.obligationMessage(p, change); msg != "" {
+					s.Obligations = append(s.Obligations, msg)
+				}
+			}
+		}
+	}
+
+	totals := make(map[ScoreSource]int)
+	for _, change := range p.ScoreChanges {
+		if change.LogIndex >= start {
+			totals[change.Source] += change.Points
+			s.Score += change.Points
+		}
+	}
+	for _, source := range scoreSources {
+		if points := totals[source]; points != 0 {
+			s.Sources = append(s.Sources, &ScoreSubtotal{Source: source.String(), Points: points})
+		}
+	}
+	return s
+}
+
+// obligationMessage describes the change from the perspective of the player, or returns the empty
+// string if the change does not concern the player.
+func (g *Game) obligationMessage(p *Player, change *obligationChange) string {
+	l := g.Locale()
+	gift := l.Name(change.gift)
+	switch {
+	case change.creditorID == p.ID() && !change.canceled:
+		return l.Sprintf("summary.owes-you", g.NameByPID(change.debtorID), gift)
+	case change.creditorID == p.ID():
+		return l.Sprintf("summary.no-longer-owes-you", g.NameByPID(change.debtorID))
+	case change.debtorID == p.ID() && !change.canceled:
+		return l.Sprintf("summary.you-owe", g.NameByPID(change.creditorID), gift)
+	case change.debtorID == p.ID():
+		return l.Sprintf("summary.you-no-longer-owe", g.NameByPID(change.creditorID))
+	}
+	return ""
+}
+
+// SinceLastTurn summarises the game since the last turn of the user cu, for the banner of the
+// game page, or returns nil if the user is not a player.
+func (g *Game) SinceLastTurn(cu *user.User) *TurnSummary {
+	if cu == nil {
+		return nil
+	}
+	return g.SummaryFor(g.PlayerByUserID(cu.ID()))
+}
+
+// summaryEffects lists the changes to the score, influence and gift obligations reported by the summary.
+func (g *Game) summaryEffects(s *TurnSummary) []string {
+	l := g.Locale()
+	var effects []string
+	if s.Score != 0 {
+		sources := make([]string, len(s.Sources))
+		for i, subtotal := range s.Sources {
+			sources[i] = fmt.Sprintf("%s %+d", subtotal.Source, subtotal.Points)
+		}
+		effects = append(effects, l.Sprintf("summary.score", s.Score, strings.Join(sources, ", ")))
+	}
+	if s.Influence != 0 {
+		effects = append(effects, l.Sprintf("summary.influence", s.Influence))
+	}
+	return append(effects, s.Obligations...)
+}
+
+// SummaryHTML renders the summary for the banner of the game page and turn notifications.
+func (g *Game) SummaryHTML(s *TurnSummary) template.HTML {
+	if s.Empty() {
+		return ""
+	}
+
+	l := g.Locale()
+	var b strings.Builder
+	fmt.Fprintf(&b, `<div class="since-last-turn"><h4>%s</h4>`, template.HTMLEscapeString(l.Sprintf("summary.heading")))
+	if effects := g.summaryEffects(s); len(effects) > 0 {
+		b.WriteString(`<ul class="effects">`)
+		for _, effect := range effects {
+			fmt.Fprintf(&b, `<li>%s</li>`, template.HTMLEscapeString(effect))
+		}
+		b.WriteString(`</ul>`)
+	}
+	for _, group := range s.Groups {
+		fmt.Fprintf(&b, `<h5>%s</h5><ul>`, template.HTMLEscapeString(group.Name))
+		for _, entry := range group.Entries {
+			fmt.Fprintf(&b, `<li>%s</li>`, entry)
+		}
+		b.WriteString(`</ul>`)
+	}
+	b.WriteString(`</div>`)
+	return template.HTML(b.String())
+}
+
+// SummaryText renders the summary in plain text for turn notifications.
+func (g *Game) SummaryText(s *TurnSummary) string {
+	if s.Empty() {
+		return ""
+	}
+
+	tb := new(textBoard)
+	tb.section(g.Locale().Sprintf("summary.heading"))
+	for _, effect := range g.summaryEffects(s) {
+		tb.line(1, effect)
+	}
+	for _, group := range s.Groups {
+		tb.line(1, group.Name)
+		for _, entry := range group.Entries {
+			tb.line(2, plainText(entry))
+		}
+	}
+	return tb.String()
+}
+
+var (
+	blockTags = regexp.MustCompile(`(?i)</?(div|p|br|li|ul|h[1-6])\b[^>]*>`)
+	htmlTags  = regexp.MustCompile(`<[^>]*>`)
+)
+
+// plainText renders the HTML of a log entry as plain text.  Block elements separate the text they
+// enclose, other tags are dropped, and entities are unescaped.
+func plainText(h template.HTML) string {
+	s := blockTags.ReplaceAllString(string(h), " ")
+	s = htmlTags.ReplaceAllString(s, "")
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
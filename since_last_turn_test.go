@@ -0,0 +1,26 @@
+package confucius
+
+import (
+	"html/template"
+	"testing"
+)
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		html template.HTML
+		want string
+	}{
+		{"player1 passed.", "player1 passed."},
+		{"<div>Bingbu ministry resolved.</div><div>player1 awarded 3 points.</div>",
+			"Bingbu ministry resolved. player1 awarded 3 points."},
+		{"<p>player1 gave a <b>Tea</b> gift to player2.</p>", "player1 gave a Tea gift to player2."},
+		{"player1 &amp; player2 received &lt;2&gt; &#39;coins&#39;.", "player1 & player2 received <2> 'coins'."},
+		{"<div>\n  player1\n  passed.\n</div>", "player1 passed."},
+	}
+
+	for _, tt := range tests {
+		if got := plainText(tt.html); got != tt.want {
+			t.Errorf("plainText(%q): got %q, want %q", tt.html, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package confucius
+
+import (
+	"encoding/gob"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/contest"
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/sn"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gob.RegisterName("*game.courtCardEntry", new(courtCardEntry))
+	gob.RegisterName("*game.soloResultEntry", new(soloResultEntry))
+}
+
+const (
+	soloResultKind = "SoloResult"
+
+	// Default number of points a solo player must reach to defeat the Emperor's court.
+	defaultSoloTarget = 35
+
+	// Number of court cards drawn at the start of each round.
+	courtCardsPerRound = 2
+
+	// Number of junks the court sends on a voyage.
+	courtVoyageJunks = 2
+)
+
+// CourtActionID identifies the action taken by the Emperor's court when a court card is drawn.
+type CourtActionID int
+
+const (
+	CourtBribe CourtActionID = iota
+	CourtNominate
+	CourtInvade
+	CourtVoyage
+)
+
+var courtActionIDStrings = map[CourtActionID]string{
+	CourtBribe:    "Bribe Official",
+	CourtNominate: "Nominate Student",
+	CourtInvade:   "Invade Land",
+	CourtVoyage:   "Start Voyage",
+}
+
+func (id CourtActionID) String() string {
+	return courtActionIDStrings[id]
+}
+
+// CourtCard directs a single action of the Emperor's court.  Ministry is only used by bribes.
+type CourtCard struct {
+	Action   CourtActionID
+	Ministry MinistryID
+}
+
+type CourtCards []*CourtCard
+
+func NewCourtDeck() CourtCards {
+	var deck CourtCards
+	for _, mid := range ministeryIDS {
+		deck = append(deck, &CourtCard{Action: CourtBribe, Ministry: mid}, &CourtCard{Action: CourtBribe, Ministry: mid})
+	}
+	for _, action := range []CourtActionID{CourtNominate, CourtInvade, CourtVoyage} {
+		deck = append(deck, &CourtCard{Action: action}, &CourtCard{Action: action})
+	}
+	return deck
+}
+
+func (cs *CourtCards) Draw() *CourtCard {
+	if len(*cs) == 0 {
+		return nil
+	}
+	i := sn.MyRand.Intn(len(*cs))
+	card := (*cs)[i]
+	*cs = append((*cs)[:i], (*cs)[i+1:]...)
+	return card
+}
+
+func (g *Game) drawCourtCard() *CourtCard {
+	if len(g.CourtDeck) == 0 {
+		g.CourtDeck = g.CourtDiscard
+		g.CourtDiscard = CourtCards{}
+	}
+	card := g.CourtDeck.Draw()
+	if card != nil {
+		g.CourtDiscard = append(g.CourtDiscard, card)
+	}
+	return card
+}
+
+func (g *Game) createCourt() {
+	g.createNeutralFamily()
+	g.CourtDeck = NewCourtDeck()
+	if g.SoloTarget <= 0 {
+		g.SoloTarget = defaultSoloTarget
+	}
+}
+
+// courtRound draws court cards and performs the directed actions through the same player actions
+// used by the human player.  The court pays no cards, cubes or other costs.
+func (g *Game) courtRound() {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	if g.Neutral == nil {
+		return
+	}
+
+	for i := 0; i < courtCardsPerRound; i++ {
+		card := g.drawCourtCard()
+		if card == nil {
+			return
+		}
+		g.Neutral.newCourtCardEntry(card, g.courtAction(card))
+	}
+}
+
+// courtAction performs the action of the card, returning false if the action could not be taken.
+func (g *Game) courtAction(card *CourtCard) bool {
+	court := g.Neutral
+	switch card.Action {
+	case CourtBribe:
+		m := g.Ministries[card.Ministry]
+		if m == nil {
+			return false
+		}
+		o := m.cheapestUnbribedOfficial()
+		if o == nil {
+			return false
+		}
+		court.bribeOfficial(m, o, nil)
+		return true
+	case CourtNominate:
+		can := g.Candidate()
+		if g.Round == 1 || can == nil || !can.Playable() || !can.hasSpaceFor(court) || court.Equal(can.OtherPlayer()) {
+			return false
+		}
+		court.nominateStudent(nil)
+		return true
+	case CourtInvade:
+		box := g.courtInvasionBox()
+		if box == nil {
+			return false
+		}
+		court.RecruitedArmies += 1
+		court.invadeLand(box, nil)
+		return true
+	case CourtVoyage:
+		if !g.hasDistantLandFor(court) {
+			return false
+		}
+		junks := courtVoyageJunks
+		if g.Junks < junks {
+			junks = g.Junks
+		}
+		if junks == 0 {
+			return false
+		}
+		g.Junks -= junks
+		court.Junks += junks
+		court.startVoyage(junks, nil)
+		return true
+	}
+	return false
+}
+
+// courtInvasionBox returns the most valuable uninvaded box of the first unresolved foreign land.
+func (g *Game) courtInvasionBox() *ForeignLandBox {
+	for _, land := range g.ForeignLands {
+		if land.Resolved {
+			continue
+		}
+		var box *ForeignLandBox
+		for _, b := range land.Boxes {
+			if b.NotInvaded() && (box == nil || b.Points > box.Points) {
+				box = b
+			}
+		}
+		if box != nil {
+			return box
+		}
+	}
+	return nil
+}
+
+type courtCardEntry struct {
+	*Entry
+	Card  *CourtCard
+	Taken bool
+}
+
+func (p *Player) newCourtCardEntry(card *CourtCard, taken bool) *courtCardEntry {
+	g := p.Game()
+	e := new(courtCardEntry)
+	e.Entry = p.newEntry()
+	e.Card = card
+	e.Taken = taken
+	p.Log = append(p.Log, e)
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *courtCardEntry) HTML() template.HTML {
+	l := e.locale()
+	action := l.Name(e.Card.Action.String())
+	if e.Card.Action == CourtBribe {
+		action = fmt.Sprintf("%s (%s)", action, l.Name(ministryIDStrings[e.Card.Ministry]))
+	}
+	if e.Taken {
+		return l.HTML("entry.court-card", e.Player().Name(), action)
+	}
+	return l.HTML("entry.court-card-not-taken", e.Player().Name(), action)
+}
+
+// soloChooseChiefMinister keeps the solo player as Chief Minister, as there is no one else to choose.
+func (g *Game) soloChooseChiefMinister() error {
+	cm := g.ChiefMinister()
+	cm.PlaceCubesIn(ImperialFavourSpace, 1)
+	cm.clearActions()
+	g.SetCurrentPlayerers(cm)
+	return g.actionsPhase()
+}
+
+// endSoloGame records whether the solo player reached the target score.  It returns a non-nil,
+// empty set of contests, as solo games do not affect ratings.
+func (g *Game) endSoloGame() ([]*contest.Contest, error) {
+	if err := g.setPhase(AnnounceWinners); err != nil {
+		return nil, err
+	}
+	g.Status = game.Completed
+	g.SetCurrentPlayerers()
+
+	p := g.Players()[0]
+	won := p.Score >= g.SoloTarget
+	if won {
+		g.WinnerIDS = append(g.WinnerIDS, p.ID())
+	}
+	p.newSoloResultEntry(g.SoloTarget, won)
+	return []*contest.Contest{}, nil
+}
+
+type soloResultEntry struct {
+	*Entry
+	Target int
+	Won    bool
+}
+
+func (p *Player) newSoloResultEntry(target int, won bool) *soloResultEntry {
+	g := p.Game()
+	e := new(soloResultEntry)
+	e.Entry = p.newEntry()
+	e.Target = target
+	e.Won = won
+	p.Log = append(p.Log, e)
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *soloResultEntry) HTML() template.HTML {
+	l := e.locale()
+	if e.Won {
+		return l.HTML("entry.solo-won", e.Player().Name(), e.Target)
+	}
+	return l.HTML("entry.solo-lost", e.Player().Name(), e.Target)
+}
+
+// SoloResult records the outcome of a solo game.  Results are kept apart from ratings and are
+// stored as children of the user's key.
+type SoloResult struct {
+	Key       *datastore.Key `datastore:"__key__"`
+	GameID    int64
+	Score     int
+	Target    int
+	Won       bool
+	Rounds    int
+	CreatedAt time.Time
+}
+
+func newSoloResultKey(uid, gid int64) *datastore.Key {
+	return datastore.IDKey(soloResultKind, gid, user.NewKey(uid))
+}
+
+// soloResult returns the result of a completed solo game, or nil for other games.
+func (g *Game) soloResult() *SoloResult {
+	if !g.HasVariant(SoloVariant) || len(g.UserIDS) != 1 || len(g.Players()) != 1 {
+		return nil
+	}
+	p := g.Players()[0]
+	return &SoloResult{
+		Key:       newSoloResultKey(g.UserIDS[0], g.ID()),
+		GameID:    g.ID(),
+		Score:     p.Score,
+		Target:    g.SoloTarget,
+		Won:       p.Score >= g.SoloTarget,
+		Rounds:    g.Round,
+		CreatedAt: time.Now(),
+	}
+}
+
+// soloResults serves the solo results of the user to the user, or to an admin.
+func (client *Client) soloResults(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	uid, err := strconv.ParseInt(c.Param("uid"), 10, 64)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	cu, err := client.User.Current(c)
+	if err != nil || (cu.ID() != uid && !cu.IsAdmin()) {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	var rs []*SoloResult
+	q := datastore.NewQuery(soloResultKind).Ancestor(user.NewKey(uid))
+	_, err = client.DS.GetAll(c, q, &rs)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	sort.Slice(rs, func(i, j int) bool { return rs[i].CreatedAt.After(rs[j].CreatedAt) })
+
+	var won int
+	for _, r := range rs {
+		if r.Won {
+			won++
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Played":  len(rs),
+		"Won":     won,
+		"Results": rs,
+	})
+}
@@ -0,0 +1,331 @@
+package confucius
+
+import (
+	"encoding/gob"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/contest"
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/restful"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gob.RegisterName("*game.advanceStalledEntry", new(advanceStalledEntry))
+}
+
+// Stalled returns why the running game has no legal continuation, or nil if its current players
+// may continue it.
+func (g *Game) Stalled() Reasons {
+	r := &reasonCheck{g: g}
+	if g.Status != game.Running {
+		return nil
+	}
+
+	if !isTurnPhase(g.Phase) {
+		return r.require(false, "stall.no-turn-phase", g.PhaseName()).reasons
+	}
+
+	ps := g.currentPlayers()
+	r.require(len(ps) > 0, "stall.no-current-player", g.PhaseName())
+	for _, p := range ps {
+		r.require(g.canContinue(p), "stall.no-legal-action", g.NameFor(p), g.PhaseName())
+	}
+	return r.reasons
+}
+
+func (g *Game) currentPlayers() Players {
+	var ps Players
+	for _, p := range g.CurrentPlayerers() {
+		ps = append(ps, p.(*Player))
+	}
+	return ps
+}
+
+// canContinue reports whether the current player may finish their turn or take an action that
+// can succeed.
+func (g *Game) canContinue(p *Player) bool {
+	switch {
+	case p.PerformedAction:
+		return true
+	case g.Phase == Discard:
+		return len(p.ConCardHand) > 4
+	case g.Phase == ImperialExamination:
+		return len(p.ConCardHand) > 0
+	case p.User() == nil:
+		return false
+	}
+
+	for _, a := range g.LegalActions(p.User()) {
+		if a.usable() {
+			return true
+		}
+	}
+	return false
+}
+
+// usable reports whether each field of the action selecting an official, player, box or land
+// has a value to select.
+func (a *LegalAction) usable() bool {
+	for _, f := range a.Fields {
+		switch f.Kind {
+		case officialField, playerField, boxField, landField:
+			if len(f.Options) == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// fallbackPlayer returns the player on whose behalf a stalled game without current player
+// continues: the Chief Minister or, failing one, the first player.
+func (g *Game) fallbackPlayer() *Player {
+	if cm := g.ChiefMinister(); cm != nil && !cm.isNeutral() {
+		return cm
+	}
+	if ps := g.Players(); len(ps) > 0 {
+		return ps[0]
+	}
+	return nil
+}
+
+// autoStep applies the step by which the rules continue the stalled game.  It returns the player
+// whose turn must then be finished, or nil if the game instead awaits the turn of a new current
+// player.  It fails, leaving the game unchanged, where the rules define no such step.
+func (g *Game) autoStep() (*Player, error) {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	if g.Stalled().None() {
+		return nil, g.vError("error.game-not-stalled")
+	}
+
+	if !isTurnPhase(g.Phase) {
+		return nil, g.vError("error.cannot-advance-phase", g.PhaseName())
+	}
+
+	for _, p := range g.currentPlayers() {
+		if g.canContinue(p) {
+			continue
+		}
+
+		if p.canPass() {
+			p.autoPass()
+		} else {
+			p.PerformedAction = true
+		}
+		g.newAdvanceStalledEntry(p)
+		return p, nil
+	}
+
+	// No current player.  The rules define how only some phases continue; others are left to an
+	// admin editing the game.
+	fallback := g.fallbackPlayer()
+	switch {
+	case g.Phase == ChooseChiefMinister && fallback != nil:
+		g.newAdvanceStalledEntry(nil)
+		g.SetCurrentPlayerers(fallback)
+		return nil, nil
+	case g.Phase == Actions && fallback != nil:
+		g.newAdvanceStalledEntry(nil)
+		if p := g.actionPhaseNextPlayer(fallback); p != nil {
+			g.SetCurrentPlayerers(p)
+			return nil, nil
+		}
+		return nil, g.imperialFavourPhase()
+	case (g.Phase == MinistryResolution || g.Phase == FinalMinistryResolution) && g.Decision != nil:
+		if p := g.Decision.awaits(g); p != nil {
+			g.newAdvanceStalledEntry(nil)
+			g.SetCurrentPlayerers(p)
+			return nil, nil
+		}
+	}
+	return nil, g.vError("error.cannot-advance-phase", g.PhaseName())
+}
+
+// forceDefault makes the decision awaited during the resolution of a ministry by default, as where
+// its player fails to decide in time.  A player awaited to decide may always continue, so the game
+// is never stalled by the decision.  It returns the player whose turn must then be finished.
+func (g *Game) forceDefault() (*Player, error) {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	if g.Decision == nil {
+		return nil, g.vError("error.no-pending-decision")
+	}
+
+	p := g.Decision.awaits(g)
+	if p == nil || !g.decideByDefault() {
+		return nil, g.vError("error.no-pending-decision")
+	}
+	return p, nil
+}
+
+type advanceStalledEntry struct {
+	*Entry
+}
+
+// newAdvanceStalledEntry logs the advance of the stalled turn of the player, or of the stalled
+// game where the player is nil.
+func (g *Game) newAdvanceStalledEntry(p *Player) *advanceStalledEntry {
+	e := new(advanceStalledEntry)
+	if p == nil {
+		e.Entry = g.newEntry()
+	} else {
+		e.Entry = p.newEntry()
+		p.Log = append(p.Log, e)
+	}
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *advanceStalledEntry) HTML() template.HTML {
+	l := e.locale()
+	if p := e.Player(); p != nil {
+		return l.HTML("entry.advance-stalled-turn", p.Name())
+	}
+	return l.HTML("entry.advance-stalled-game")
+}
+
+// advance lets an admin continue a stalled game by the step the rules apply automatically.
+func (client *Client) advance(prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client.Log.Debugf(msgEnter)
+		defer client.Log.Debugf(msgExit)
+
+		g := gameFrom(c)
+		if g == nil {
+			client.Log.Errorf("game not found")
+			c.Redirect(http.StatusSeeOther, homePath)
+			return
+		}
+
+		cu, err := client.User.Current(c)
+		if err != nil || !cu.IsAdmin() {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		oldCP := g.CurrentPlayer()
+		p, err := g.autoStep()
+		if err == nil {
+			err = client.finishAdvance(c, g, cu, oldCP, p)
+		}
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			restful.AddErrorf(c, err.Error())
+		}
+		c.Redirect(http.StatusSeeOther, showPath(c, prefix))
+	}
+}
+
+// decideDefault lets an admin make the decision awaited during the resolution of a ministry by
+// default, where its player fails to decide in time.
+func (client *Client) decideDefault(prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client.Log.Debugf(msgEnter)
+		defer client.Log.Debugf(msgExit)
+
+		g := gameFrom(c)
+		if g == nil {
+			client.Log.Errorf("game not found")
+			c.Redirect(http.StatusSeeOther, homePath)
+			return
+		}
+
+		cu, err := client.User.Current(c)
+		if err != nil || !cu.IsAdmin() {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		oldCP := g.CurrentPlayer()
+		p, err := g.forceDefault()
+		if err == nil {
+			err = client.finishAdvance(c, g, cu, oldCP, p)
+		}
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			restful.AddErrorf(c, err.Error())
+		}
+		c.Redirect(http.StatusSeeOther, showPath(c, prefix))
+	}
+}
+
+// finishAdvance finishes the turn of the player, if any, on whose behalf an admin continued the
+// game, and saves the game.
+func (client *Client) finishAdvance(c *gin.Context, g *Game, cu *user.User, oldCP, p *Player) error {
+	var cs []*contest.Contest
+	if p != nil {
+		var err error
+		_, cs, err = client.finishTurn(c, g, p.User())
+		if err != nil {
+			return err
+		}
+	}
+	return client.saveTurn(c, g, cu, oldCP, nil, cs)
+}
+
+// cronHeader is set by App Engine on requests of the jobs scheduled by cron.yaml, and stripped from
+// any other request.
+const cronHeader = "X-Appengine-Cron"
+
+// stalledJSON sweeps the running games, logging and listing those having no legal continuation.
+// It is requested periodically by the job scheduled by cron.yaml, and may be requested by an admin.
+func (client *Client) stalledJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	if c.GetHeader(cronHeader) != "true" {
+		cu, err := client.User.Current(c)
+		if err != nil || !cu.IsAdmin() {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+	}
+
+	q := datastore.NewQuery(kind).
+		Ancestor(pk(c)).
+		Filter("Status=", int(game.Running)).
+		KeysOnly()
+
+	ks, err := client.DS.GetAll(c, q, nil)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	type stalled struct {
+		ID        int64
+		Title     string
+		Round     int
+		Phase     string
+		UpdatedAt time.Time
+		Reasons   Reasons
+	}
+
+	ss := []*stalled{}
+	for _, k := range ks {
+		g := New(c, k.ID)
+		err = client.dsGet(c, g)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			continue
+		}
+
+		if rs := g.Stalled(); !rs.None() {
+			client.Log.Warningf("game %d stalled: %s", g.ID(), strings.Join(rs, "; "))
+			ss = append(ss, &stalled{ID: g.ID(), Title: g.Title, Round: g.Round, Phase: g.PhaseName(),
+				UpdatedAt: g.UpdatedAt, Reasons: rs})
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"Games": ss})
+}
@@ -0,0 +1,105 @@
+package confucius
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SlothNinja/game"
+)
+
+func TestForceDefault(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		n  = NeutralPlayerID
+	)
+	c, g := newTestGame(t, 2, TwoPlayerVariant)
+	m := g.Ministries[Bingbu]
+	holders := map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p1, 5: n, 6: n}
+	costs := make(map[Seniority]int)
+	for seniority := range holders {
+		costs[seniority] = 1
+	}
+	setOfficials(m, costs, holders)
+	g.Phase = MinistryResolution
+
+	if g.initMinistryResolution(c, m) {
+		t.Fatal("resolution completed, want decision awaited")
+	}
+	decider := g.PlayerByID(p0)
+	g.SetCurrentPlayerers(decider)
+
+	// The awaited player may continue by deciding, so the game is not stalled.
+	if rs := g.Stalled(); !rs.None() {
+		t.Fatalf("stalled: %v", rs)
+	}
+	if _, err := g.autoStep(); err == nil {
+		t.Fatal("advanced a game that is not stalled")
+	}
+
+	p, err := g.forceDefault()
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case p != decider:
+		t.Errorf("player: got %v, want %v", p, decider)
+	case g.Decision != nil && g.Decision.PlayerID == p0:
+		t.Errorf("decision still awaited: %+v", g.Decision)
+	case !p.PerformedAction:
+		t.Error("turn of the player not completed")
+	}
+
+	g.Decision = nil
+	if _, err := g.forceDefault(); err == nil {
+		t.Error("forced a default without an awaited decision")
+	}
+}
+
+func TestAutoStepWithoutCurrentPlayer(t *testing.T) {
+	tests := []struct {
+		name     string
+		phase    game.Phase
+		wantStep bool
+	}{
+		{"choose chief minister", ChooseChiefMinister, true},
+		{"actions", Actions, true},
+		{"discard", Discard, false},
+		{"imperial examination", ImperialExamination, false},
+		{"ministry resolution without decision", MinistryResolution, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 3)
+			g.Phase = tt.phase
+			g.SetCurrentPlayerers()
+			logged := len(g.Log)
+
+			if rs := g.Stalled(); rs.None() {
+				t.Fatal("game without current player not stalled")
+			}
+			p, err := g.autoStep()
+			if !tt.wantStep {
+				if want := g.Locale().Sprintf("error.cannot-advance-phase", g.PhaseName()); err == nil ||
+					strings.TrimSpace(err.Error()) != want {
+					t.Errorf("error: got %v, want %q", err, want)
+				}
+				if len(g.Log) != logged || len(g.CurrentPlayerers()) != 0 {
+					t.Error("game changed by a step the rules do not define")
+				}
+				return
+			}
+
+			switch {
+			case err != nil:
+				t.Fatal(err)
+			case p != nil:
+				t.Errorf("player: got %v, want none", p)
+			case len(g.CurrentPlayerers()) != 1:
+				t.Errorf("current players: got %d, want 1", len(g.CurrentPlayerers()))
+			case len(g.Log) != logged+1:
+				t.Errorf("log entries: got %d, want %d", len(g.Log)-logged, 1)
+			}
+		})
+	}
+}
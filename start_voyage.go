@@ -2,14 +2,12 @@ package confucius
 
 import (
 	"encoding/gob"
-	"fmt"
 	"html/template"
 	"strconv"
 
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -29,68 +27,87 @@ func (g *Game) startVoyage(c *gin.Context, cu *user.User) (string, game.ActionTy
 	}
 
 	cp := g.CurrentPlayer()
-	cp.PerformedAction = true
 
 	// Place Action Cubes
 	cp.PlaceCubesIn(JunksVoyageSpace, cubes)
 
-	// Sail Junks
-	completedVoyages := (cp.OnVoyage + junks) / 5
-	cp.OnVoyage = (cp.OnVoyage + junks) % 5
-	cp.Junks -= junks
-	g.Junks += completedVoyages * 5
+	left := g.voyagesLeft()
+	e := cp.launchVoyage(junks, cards)
+
+	// Set flash message
+	restful.AddNoticef(c, string(e.HTML()))
+	return "", g.commitIfDrawn(cp, left), nil
+}
+
+// startVoyage sails the junks of the player, resolving each completed voyage in the distant land
+// chosen by default, as for the court of the solo game.
+func (p *Player) startVoyage(junks int, cards ConCards) *startVoyageEntry {
+	g := p.Game()
+	completed := p.sail(junks, cards)
 
 	lands := DistantLands{}
 	points := []int{}
-	emperorCards := make([]bool, completedVoyages)
-	// For Each Completed Voyage Find Index of Land with Max VP
-	for j := 0; j < completedVoyages; j++ {
-		var max int
-		land := new(DistantLand)
-		for _, l := range g.DistantLands {
-			if l.Chit.Value() > max {
-				max = l.Chit.Value()
-				land = l
-			}
+	emperorCards := []bool{}
+	for j := 0; j < completed; j++ {
+		land := g.defaultDistantLandFor(p)
+		if land == nil {
+			break
 		}
+		scored, drawn := p.completeVoyage(land)
+		lands = append(lands, land)
+		points = append(points, scored)
+		emperorCards = append(emperorCards, drawn)
+	}
 
-		// All Tiles Taken.  Find First Land without current player
-		if max == 0 {
-			for _, l := range g.DistantLands {
-				if !l.Players().Include(cp) {
-					land = l
-					break
-				}
-			}
-		}
+	// Create Action Object for logging
+	return p.newStartVoyageEntry(cards, junks, lands, points, emperorCards, 0)
+}
 
-		scored := 0
-		if land.Chit != NoChit {
-			scored = land.Chit.Value()
-		}
-		cp.Score += scored
-		points = append(points, scored)
+// launchVoyage sails the junks of the player, who then chooses the distant land of each completed
+// voyage.  Voyages for which a single distant land remains are resolved at once.
+func (p *Player) launchVoyage(junks int, cards ConCards) *startVoyageEntry {
+	completed := p.sail(junks, cards)
+	p.PendingVoyages += completed
+	e := p.newStartVoyageEntry(cards, junks, DistantLands{}, []int{}, []bool{}, completed)
+	p.resolveForcedVoyages()
+	return e
+}
 
-		if len(g.EmperorDeck) > 0 {
-			card := g.EmperorDeck.Draw()
-			cp.EmperorHand.Append(card)
-			emperorCards[j] = true
-		}
-		land.Chit = NoChit
-		land.SetPlayers(append(land.Players(), cp))
-		lands = append(lands, land)
-	}
+// sail sails the junks of the player, playing the cards, and returns the number of voyages completed.
+func (p *Player) sail(junks int, cards ConCards) int {
+	g := p.Game()
+	p.PerformedAction = true
+
+	// Sail Junks
+	completed := (p.OnVoyage + junks) / 5
+	p.OnVoyage = (p.OnVoyage + junks) % 5
+	p.Junks -= junks
+	g.Junks += completed * 5
 
 	// Move played cards from hand to discard pile
-	cp.ConCardHand.Remove(cards...)
+	p.ConCardHand.Remove(cards...)
 	g.ConDiscardPile.Append(cards...)
+	return completed
+}
 
-	// Create Action Object for logging
-	e := cp.newStartVoyageEntry(cards, junks, lands, points, emperorCards)
+// completeVoyage completes a voyage of the player in the land, returning the points scored and
+// whether an Emperor's Reward card was drawn.
+func (p *Player) completeVoyage(land *DistantLand) (int, bool) {
+	g := p.Game()
+	scored := 0
+	if land.Chit != NoChit {
+		scored = land.Chit.Value()
+	}
+	p.addScore(VoyageScore, scored)
 
-	// Set flash message
-	restful.AddNoticef(c, string(e.HTML()))
-	return "", game.Cache, nil
+	drawn := false
+	if len(g.EmperorDeck) > 0 {
+		p.EmperorHand.Append(g.EmperorDeck.Draw())
+		drawn = true
+	}
+	land.Chit = NoChit
+	land.SetPlayers(append(land.Players(), p))
+	return scored, drawn
 }
 
 type startVoyageEntry struct {
@@ -100,9 +117,10 @@ type startVoyageEntry struct {
 	DistantLands DistantLands
 	MultiPoints  []int
 	EmperorCards []bool
+	Completed    int // Voyages completed whose distant lands are chosen by the player
 }
 
-func (p *Player) newStartVoyageEntry(c ConCards, j int, l DistantLands, mp []int, ec []bool) *startVoyageEntry {
+func (p *Player) newStartVoyageEntry(c ConCards, j int, l DistantLands, mp []int, ec []bool, completed int) *startVoyageEntry {
 	g := p.Game()
 	e := new(startVoyageEntry)
 	e.Entry = p.newEntry()
@@ -111,21 +129,29 @@ func (p *Player) newStartVoyageEntry(c ConCards, j int, l DistantLands, mp []int
 	e.DistantLands = l
 	e.MultiPoints = mp
 	e.EmperorCards = ec
+	e.Completed = completed
 	p.Log = append(p.Log, e)
 	g.Log = append(g.Log, e)
 	return e
 }
 
 func (e *startVoyageEntry) HTML() template.HTML {
+	l := e.locale()
 	length := len(e.Played)
 	licenses := e.Played.Licenses()
 
-	s := fmt.Sprintf("<div>%s spent %d Confucius %s having %d %s to send %d %s on a voyage.</div>", e.Player().Name(), length, pluralize("card", length), licenses, pluralize("license", licenses), e.Junks, pluralize("junk", e.Junks))
+	s := "<div>" + l.Sprintf("entry.start-voyage-paid", e.Player().Name(), length, l.Plural("card", length), licenses, l.Plural("license", licenses), e.Junks, l.Plural("junk", e.Junks)) + "</div>"
+	if length == 0 {
+		s = "<div>" + l.Sprintf("entry.start-voyage", e.Player().Name(), e.Junks, l.Plural("junk", e.Junks)) + "</div>"
+	}
+	if e.Completed > 0 {
+		s += "<div>" + l.Sprintf("entry.voyages-completed", e.Player().Name(), e.Completed, l.Plural("voyage", e.Completed)) + "</div>"
+	}
 	for i, land := range e.DistantLands {
 		if e.EmperorCards[i] {
-			s += fmt.Sprintf("<div>%s completed voyage to %s, scored %d points, and received an Emperor Reward card.</div>", e.Player().Name(), land.Name(), e.MultiPoints[i])
+			s += "<div>" + l.Sprintf("entry.voyage-completed-reward", e.Player().Name(), l.Name(land.Name()), e.MultiPoints[i]) + "</div>"
 		} else {
-			s += fmt.Sprintf("<div>%s completed voyage to %s, scored %d points, and did not receive an Emperor Reward card.", e.Player().Name(), land.Name(), e.MultiPoints[i])
+			s += "<div>" + l.Sprintf("entry.voyage-completed", e.Player().Name(), l.Name(land.Name()), e.MultiPoints[i]) + "</div>"
 		}
 	}
 	return template.HTML(s)
@@ -147,25 +173,30 @@ func (g *Game) validateStartVoyage(c *gin.Context, cu *user.User) (int, ConCards
 	licenses := cards.Licenses()
 	switch {
 	case err != nil:
-		return 0, nil, 0, sn.NewVError("Invalid value for junks received.")
+		return 0, nil, 0, g.vError("error.invalid-value-junks-received")
 	case licenses < junks:
-		return 0, nil, 0, sn.NewVError("You selected cards having %d total licenses, but you need %d licenses to start a voyage with %d junks.", licenses, junks, junks)
+		return 0, nil, 0, g.vError("error.insufficient-licenses-voyage", licenses, junks, junks)
 	case cp.Junks < junks:
-		return 0, nil, 0, sn.NewVError("You have selected %d junks for the voyage, buy only have %d junks available.", junks, cp.Junks)
+		return 0, nil, 0, g.vError("error.selected-junks-voyage-buy-only-junks", junks, cp.Junks)
 	case !g.hasDistantLandFor(cp):
-		return 0, nil, 0, sn.NewVError("There are no distant lands to which you can voyage.")
+		return 0, nil, 0, g.vError("error.there-no-distant-lands-can-voyage")
 	}
 
 	return junks, cards, cubes, nil
 }
 
 func (g *Game) EnableStartVoyage(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canStartVoyage()
+	return g.StartVoyageReasons(cu).None()
 }
 
-func (p *Player) canStartVoyage() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction && p.hasEnoughCubesFor(JunksVoyageSpace) &&
-		p.hasJunks() && p.hasLicenses()
+// StartVoyageReasons returns why the user cu may not start a voyage.
+func (g *Game) StartVoyageReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, JunksVoyageSpace).
+		require(cp.hasJunks(), "reason.no-junks").
+		require(cp.hasLicenses(), "reason.no-licenses")
+	return r.reasons
 }
@@ -38,7 +38,7 @@ func (g *Game) taxIncome(c *gin.Context, cu *user.User) (string, game.ActionType
 
 	// Set flash message
 	restful.AddNoticef(c, string(entry.HTML()))
-	return "", game.Cache, nil
+	return "", g.commit(cp), nil
 }
 
 type taxIncomeEntry struct {
@@ -55,15 +55,19 @@ func (p *Player) newTaxIncomeEntry() *taxIncomeEntry {
 }
 
 func (e *taxIncomeEntry) HTML() template.HTML {
-	return restful.HTML("%s received two Confucius cards of tax income.", e.Player().Name())
+	return e.locale().HTML("entry.tax-income", e.Player().Name())
 }
 
 func (g *Game) EnableTaxIncome(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canCollectTaxIncome()
+	return g.TaxIncomeReasons(cu).None()
 }
 
-func (p *Player) canCollectTaxIncome() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction && p.hasEnoughCubesFor(TaxIncomeSpace)
+// TaxIncomeReasons returns why the user cu may not collect tax income.
+func (g *Game) TaxIncomeReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.actionPhase().notPerformed(cp).cubes(cp, TaxIncomeSpace)
+	return r.reasons
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -27,6 +26,8 @@ func (g *Game) tempTransfer(c *gin.Context, cu *user.User) (string, game.ActionT
 	}
 
 	cp := g.CurrentPlayer()
+	g.Decision = nil
+
 	// Transfer Temporary Influence
 	gift := cp.transferTempInfluenceTo(p)
 	entry := cp.newTransferTempInfluenceInEntry(p, gift)
@@ -36,14 +37,6 @@ func (g *Game) tempTransfer(c *gin.Context, cu *user.User) (string, game.ActionT
 	return "", game.Cache, nil
 }
 
-func (g *Game) autoTempTransferInfluence(from, to *Player) {
-	log.Debugf(msgEnter)
-	defer log.Debugf(msgExit)
-
-	canceledGift := from.transferTempInfluenceTo(to)
-	from.newAutoTransferTempInfluenceInEntry(to, canceledGift)
-}
-
 type transferTempInfluenceInEntry struct {
 	*Entry
 	MinistryName string
@@ -65,18 +58,27 @@ func (p *Player) newTransferTempInfluenceInEntry(player *Player, gift *GiftCard)
 }
 
 func (e *transferTempInfluenceInEntry) HTML() template.HTML {
+	l := e.locale()
 	if e.GiftName == "" {
-		return restful.HTML("%s temporarily transfered influence in %s ministry to %s.",
-			e.Player().Name(), e.MinistryName, e.OtherPlayer().Name())
+		return l.HTML("entry.temp-transfer",
+			e.Player().Name(), l.Name(e.MinistryName), e.OtherPlayer().Name())
 	}
-	return restful.HTML("%s temporarily transfered influence in %s ministry to %s, and removed gift %s from play.",
-		e.Player().Name(), e.MinistryName, e.OtherPlayer().Name(), e.GiftName)
+	return l.HTML("entry.temp-transfer-gift",
+		e.Player().Name(), l.Name(e.MinistryName), e.OtherPlayer().Name(), l.Name(e.GiftName))
+}
+
+func (e *transferTempInfluenceInEntry) obligationChanges() []*obligationChange {
+	if e.GiftName == "" {
+		return nil
+	}
+	return []*obligationChange{{debtorID: e.PlayerID, creditorID: e.OtherPlayerID, gift: e.GiftName, canceled: true}}
 }
 
 type autoTransferTempInfluenceInEntry struct {
 	*Entry
 	MinistryName string
 	GiftName     string
+	Default      bool
 }
 
 func (p *Player) newAutoTransferTempInfluenceInEntry(player *Player, gift *GiftCard) *autoTransferTempInfluenceInEntry {
@@ -94,12 +96,23 @@ func (p *Player) newAutoTransferTempInfluenceInEntry(player *Player, gift *GiftC
 }
 
 func (e *autoTransferTempInfluenceInEntry) HTML() template.HTML {
+	l := e.locale()
+	s := l.Sprintf("entry.auto-temp-transfer", l.Name(e.MinistryName), e.Player().Name(), e.OtherPlayer().Name())
+	if e.GiftName != "" {
+		s = l.Sprintf("entry.auto-temp-transfer-gift",
+			l.Name(e.MinistryName), e.Player().Name(), e.OtherPlayer().Name(), l.Name(e.GiftName))
+	}
+	if e.Default {
+		s += " " + l.Sprintf("entry.decision-default", e.Player().Name())
+	}
+	return template.HTML(s)
+}
+
+func (e *autoTransferTempInfluenceInEntry) obligationChanges() []*obligationChange {
 	if e.GiftName == "" {
-		return restful.HTML("System auto-transfered influence in %s ministry temporarily from %s to %s.",
-			e.MinistryName, e.Player().Name(), e.OtherPlayer().Name())
+		return nil
 	}
-	return restful.HTML("System auto-transfered influence in %s ministry temporarily from %s to %s, and removed gift %s from play.",
-		e.MinistryName, e.Player().Name(), e.OtherPlayer().Name(), e.GiftName)
+	return []*obligationChange{{debtorID: e.PlayerID, creditorID: e.OtherPlayerID, gift: e.GiftName, canceled: true}}
 }
 
 func (g *Game) validateTempTransfer(c *gin.Context, cu *user.User) (*Player, error) {
@@ -110,55 +123,49 @@ func (g *Game) validateTempTransfer(c *gin.Context, cu *user.User) (*Player, err
 
 	cp := g.CurrentPlayer()
 	m := g.ministryInProgress()
+	d := g.Decision
 
 	switch {
 	case m == nil:
-		return nil, sn.NewVError("No ministry resolution in progress.")
+		return nil, g.vError("error.no-ministry-resolution-progress")
 	case !g.IsCurrentPlayer(cu):
-		return nil, sn.NewVError("Only the current player may perform g action.")
+		return nil, g.vError("error.only-current-player-may-perform-action")
 	case !(g.Phase == MinistryResolution || g.Phase == FinalMinistryResolution):
-		return nil, sn.NewVError("You cannot transfer influence during the %s phase.", g.PhaseName())
-	case !cp.TempPlayers().Include(p):
-		return nil, sn.NewVError("You cannot temporarily transfer influence in %s ministry to %s.",
-			m.Name(), g.NameFor(p))
+		return nil, g.vError("error.cannot-transfer-influence-during-phase", g.PhaseName())
+	case d == nil || d.Step != ChooseTransferTarget || d.PlayerID != cp.ID():
+		return nil, g.vError("error.no-transfer-awaited")
+	case !d.Includes(p):
+		return nil, g.vError("error.cannot-temporarily-transfer-influence-ministry", m.Name(), g.NameFor(p))
 	}
 	return p, nil
 }
 
 func (g *Game) EnableTempTransfer(cu *user.User) bool {
-	return g.IsCurrentPlayer(cu) && g.Phase == MinistryResolution || g.Phase == FinalMinistryResolution
+	return g.TempTransferReasons(cu).None()
+}
+
+// TempTransferReasons returns why the user cu may not temporarily transfer influence.
+func (g *Game) TempTransferReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	r.phase(MinistryResolution, FinalMinistryResolution)
+	d := g.Decision
+	r.require(d != nil && d.Step == ChooseTransferTarget && d.PlayerID == cp.ID(), "reason.no-transfer-awaited")
+	return r.reasons
 }
 
 func (p *Player) TempPlayers() Players {
-	m := p.Game().ministryInProgress()
+	g := p.Game()
+	m := g.ministryInProgress()
 	if m == nil {
 		return nil
 	}
-	var ps Players
 
-	// Restricted based on gift obligations
-	giftValue := NoGiftValue
-	for _, gift := range p.GiftsReceived {
-		player := gift.Player()
-		if player.hasTempInfluence() {
-			switch {
-			case gift.Value == giftValue:
-				ps = append(ps, player)
-			case gift.Value > giftValue:
-				ps = Players{player}
-				giftValue = gift.Value
-			}
-		}
-	}
-
-	if len(ps) > 0 {
-		return ps
-	}
-
-	// No gift obligation so any other players in ministry.
-	for _, o := range m.Officials {
-		player := o.TempPlayer()
-		if player != nil && player.NotEqual(p) && !ps.Include(player) {
+	var ps Players
+	for _, id := range m.tempInfluence().targets(p.ID(), g.giftObligations()) {
+		if player := g.PlayerByID(id); player != nil {
 			ps = append(ps, player)
 		}
 	}
@@ -0,0 +1,179 @@
+package confucius
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Ministry whose minister receives a title when its leaders are tied.
+var titleTieBreakMinistries = map[TitleID]MinistryID{
+	ChiefMinisterTitle: Hubu,
+	AdmiralTitle:       Gongbu,
+	GeneralTitle:       Bingbu,
+}
+
+// TitleCount provides the count of a player toward a title.
+type TitleCount struct {
+	PlayerID int
+	Name     string
+	Count    int
+}
+
+// TitleStanding provides the standing of the players toward a title, were it awarded now.
+// WinnerID is NoPlayerID if no title would be awarded, including when the tie-break holder
+// cannot hold the title.
+type TitleStanding struct {
+	Title            string
+	Counts           []*TitleCount
+	LeaderIDS        []int
+	TieBreakMinistry string
+	TieBreakHolderID int
+	WinnerID         int
+
+	decided bool
+	winner  *Player
+}
+
+// chiefMinisterCounts counts a player's bribed officials, and each ministry in which the player
+// is minister or secretary.
+func (g *Game) chiefMinisterCounts() playerCounts {
+	counts := make(playerCounts)
+	for _, ministry := range g.Ministries {
+		for _, official := range ministry.Officials {
+			if official.Bribed() {
+				counts.IncrementFor(official.Player())
+			}
+		}
+		if minister := ministry.Minister(); minister != nil {
+			counts[minister.ID()] += 1
+		}
+		if secretary := ministry.Secretary(); secretary != nil {
+			counts[secretary.ID()] += 1
+		}
+	}
+	return counts
+}
+
+// admiralCounts counts 5 for each junk at a distant land and 1 for each junk at sea.
+func (g *Game) admiralCounts() playerCounts {
+	counts := make(playerCounts)
+	for _, land := range g.DistantLands {
+		for _, player := range land.Players() {
+			counts.IncrementFor(player, 5)
+		}
+	}
+
+	for _, player := range g.Players() {
+		counts[player.ID()] += player.OnVoyage
+	}
+	return counts
+}
+
+// generalCounts counts 1 for each army at a foreign land, 1 for avenging the emperor and 1 for
+// each army in military colonies.
+func (g *Game) generalCounts() playerCounts {
+	counts := make(playerCounts)
+	for _, land := range g.ForeignLands {
+		for _, box := range land.Boxes {
+			if box.Player() != nil {
+				counts.IncrementFor(box.Player())
+			}
+		}
+	}
+
+	for _, player := range g.Players() {
+		if player.Equal(g.Avenger()) {
+			counts.IncrementFor(player)
+		}
+		counts[player.ID()] += player.RecruitedArmies
+	}
+	return counts
+}
+
+func (g *Game) titleCounts(title TitleID) playerCounts {
+	switch title {
+	case ChiefMinisterTitle:
+		return g.chiefMinisterCounts()
+	case AdmiralTitle:
+		return g.admiralCounts()
+	case GeneralTitle:
+		return g.generalCounts()
+	default:
+		return make(playerCounts)
+	}
+}
+
+// titleStanding returns the standing of the players toward the title without changing the game.
+// A sole leader wins the title, if having a count.  Tied leaders, including players all having no
+// count, leave the title to the minister of the tie-break ministry.
+func (g *Game) titleStanding(title TitleID) *TitleStanding {
+	counts := g.titleCounts(title)
+	s := &TitleStanding{
+		Title:            title.String(),
+		TieBreakHolderID: NoPlayerID,
+		WinnerID:         NoPlayerID,
+	}
+
+	var leaders Players
+	max := 0
+	for _, player := range g.Players() {
+		count := counts.For(player)
+		s.Counts = append(s.Counts, &TitleCount{PlayerID: player.ID(), Name: g.NameFor(player), Count: count})
+		switch {
+		case count == max:
+			leaders = append(leaders, player)
+		case count > max:
+			max = count
+			leaders = Players{player}
+		}
+	}
+
+	for _, leader := range leaders {
+		s.LeaderIDS = append(s.LeaderIDS, leader.ID())
+	}
+
+	mid := titleTieBreakMinistries[title]
+	s.TieBreakMinistry = ministryIDStrings[mid]
+	var holder *Player
+	if m := g.Ministries[mid]; m != nil {
+		holder = m.titleHolder()
+	}
+	if holder != nil {
+		s.TieBreakHolderID = holder.ID()
+	}
+
+	switch {
+	case len(leaders) > 1:
+		s.decided, s.winner = true, holder
+	case len(leaders) == 1 && max > 0:
+		s.decided, s.winner = true, leaders[0]
+	}
+
+	if s.winner != nil {
+		s.WinnerID = s.winner.ID()
+	}
+	return s
+}
+
+// TitleStandings returns the current standings toward each title.
+func (g *Game) TitleStandings() []*TitleStanding {
+	return []*TitleStanding{
+		g.titleStanding(ChiefMinisterTitle),
+		g.titleStanding(AdmiralTitle),
+		g.titleStanding(GeneralTitle),
+	}
+}
+
+func (client *Client) titlesJSON(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	g := gameFrom(c)
+	if g == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"Titles": g.TitleStandings()})
+}
@@ -0,0 +1,999 @@
+package confucius
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/SlothNinja/codec"
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+	"github.com/SlothNinja/mlog"
+	"github.com/SlothNinja/restful"
+	"github.com/SlothNinja/sn"
+	"github.com/SlothNinja/user"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	tournamentKind = "Tournament"
+
+	defaultSwissRounds  = 3
+	maxTournamentRounds = 20
+)
+
+// TournamentFormat determines how players are seated in each round of a tournament.
+type TournamentFormat int
+
+const (
+	SwissFormat TournamentFormat = iota
+	RoundRobinFormat
+)
+
+var tournamentFormatStrings = map[TournamentFormat]string{
+	SwissFormat:      "Swiss",
+	RoundRobinFormat: "Round Robin",
+}
+
+func (f TournamentFormat) String() string {
+	return tournamentFormatStrings[f]
+}
+
+// TieBreakerID identifies a criterion used to order players having the same tournament points.
+type TieBreakerID int
+
+const (
+	WinsTieBreaker TieBreakerID = iota
+	ScoreTieBreaker
+	OpponentsTieBreaker
+)
+
+var tieBreakerIDStrings = map[TieBreakerID]string{
+	WinsTieBreaker:      "Wins",
+	ScoreTieBreaker:     "Total Score",
+	OpponentsTieBreaker: "Opponents' Points",
+}
+
+func (id TieBreakerID) String() string {
+	return tieBreakerIDStrings[id]
+}
+
+var defaultTieBreakers = []TieBreakerID{WinsTieBreaker, ScoreTieBreaker, OpponentsTieBreaker}
+
+type TournamentStatus int
+
+const (
+	TournamentRunning TournamentStatus = iota
+	TournamentCompleted
+)
+
+var tournamentStatusStrings = map[TournamentStatus]string{
+	TournamentRunning:   "Running",
+	TournamentCompleted: "Completed",
+}
+
+func (s TournamentStatus) String() string {
+	return tournamentStatusStrings[s]
+}
+
+// Tournament is a series of games whose players are seated by the tournament rather than by recruiting.
+// Rounds, tables and results are kept in the encoded TournamentState.
+type Tournament struct {
+	Key        *datastore.Key `datastore:"__key__"`
+	Title      string
+	Status     TournamentStatus
+	Round      int
+	NumRounds  int
+	CreatorID  int64
+	UserIDS    []int64
+	UserNames  []string
+	Report     string `datastore:",noindex"`
+	SavedState []byte `datastore:",noindex"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+
+	*TournamentState `datastore:"-"`
+}
+
+type TournamentState struct {
+	Format      TournamentFormat
+	TableSize   int
+	Variants    GameVariantIDS
+	TieBreakers []TieBreakerID
+	Rounds      []*TournamentRound
+}
+
+type TournamentRound struct {
+	Number int
+	Tables []*TournamentTable
+}
+
+// TournamentTable provides the seating of a single game.  UserIDS are in seat order.
+type TournamentTable struct {
+	GameID    int64
+	UserIDS   []int64
+	Results   []*TournamentResult
+	Completed bool
+}
+
+type TournamentResult struct {
+	UserID int64
+	Place  int
+	Score  int
+}
+
+func newTournamentKey(c *gin.Context, id int64) *datastore.Key {
+	return datastore.IDKey(tournamentKind, id, pk(c))
+}
+
+func newTournament(c *gin.Context, id int64) *Tournament {
+	return &Tournament{
+		Key:             newTournamentKey(c, id),
+		TournamentState: new(TournamentState),
+	}
+}
+
+func (t *Tournament) encode() error {
+	encoded, err := codec.Encode(t.TournamentState)
+	if err != nil {
+		return err
+	}
+	t.SavedState = encoded
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+func (t *Tournament) decode() error {
+	s := new(TournamentState)
+	err := codec.Decode(&s, t.SavedState)
+	if err != nil {
+		return err
+	}
+	t.TournamentState = s
+	return nil
+}
+
+func (t *Tournament) ID() int64 {
+	if t == nil || t.Key == nil {
+		return 0
+	}
+	return t.Key.ID
+}
+
+func (t *Tournament) nameFor(uid int64) string {
+	for i, id := range t.UserIDS {
+		if id == uid && i < len(t.UserNames) {
+			return t.UserNames[i]
+		}
+	}
+	return ""
+}
+
+func (t *Tournament) currentRound() *TournamentRound {
+	if l := len(t.Rounds); l > 0 {
+		return t.Rounds[l-1]
+	}
+	return nil
+}
+
+func (r *TournamentRound) completed() bool {
+	for _, table := range r.Tables {
+		if !table.Completed {
+			return false
+		}
+	}
+	return true
+}
+
+// tableSizes splits n players into the fewest tables of at most size players, the sizes of
+// the tables differing by no more than one.
+func tableSizes(n, size int) []int {
+	if n <= 0 || size <= 0 {
+		return nil
+	}
+	tables := (n + size - 1) / size
+	sizes := make([]int, tables)
+	for i := range sizes {
+		sizes[i] = n / tables
+		if i < n%tables {
+			sizes[i] += 1
+		}
+	}
+	return sizes
+}
+
+func (t *Tournament) validate(l Locale) error {
+	if len(t.UserIDS) < 2 {
+		return l.VError("error.tournament-requires-at-least-2-players")
+	}
+
+	if t.TableSize < 2 || t.TableSize > 5 {
+		return l.VError("error.tables-must-seat-2-5-players")
+	}
+
+	if _, ok := tournamentFormatStrings[t.Format]; !ok {
+		return l.VError("error.received-invalid-tournament-format")
+	}
+
+	for _, id := range t.TieBreakers {
+		if _, ok := tieBreakerIDStrings[id]; !ok {
+			return l.VError("error.received-invalid-tie-breaker")
+		}
+	}
+
+	if t.NumRounds < 1 || t.NumRounds > maxTournamentRounds {
+		return l.VError("error.tournament-must-1-rounds", maxTournamentRounds)
+	}
+
+	for i, uid := range t.UserIDS {
+		for _, other := range t.UserIDS[:i] {
+			if other == uid {
+				return l.VError("error.player-may-only-enter-tournament-once")
+			}
+		}
+	}
+
+	// Each table must be able to play the selected variants.
+	for _, size := range tableSizes(len(t.UserIDS), t.TableSize) {
+		if size < 2 {
+			return l.VError("error.each-table-must-seat-at-least")
+		}
+		g := &Game{Header: &game.Header{NumPlayers: size}, State: newState()}
+		g.setVariants(t.Variants)
+		if err := g.validateVariants(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultRounds returns the number of rounds needed for each player to meet every other player,
+// when played as a round robin.
+func (t *Tournament) defaultRounds() int {
+	if t.Format == SwissFormat {
+		return defaultSwissRounds
+	}
+	return len(roundRobinSchedule(t.UserIDS, t.TableSize))
+}
+
+type meetingKey struct {
+	uid1, uid2 int64
+}
+
+func newMeetingKey(uid1, uid2 int64) meetingKey {
+	if uid1 > uid2 {
+		uid1, uid2 = uid2, uid1
+	}
+	return meetingKey{uid1, uid2}
+}
+
+// meetings returns the number of times each pair of players has been seated at the same table.
+func (t *Tournament) meetings() map[meetingKey]int {
+	ms := make(map[meetingKey]int)
+	for _, r := range t.Rounds {
+		for _, table := range r.Tables {
+			for i, uid1 := range table.UserIDS {
+				for _, uid2 := range table.UserIDS[i+1:] {
+					ms[newMeetingKey(uid1, uid2)] += 1
+				}
+			}
+		}
+	}
+	return ms
+}
+
+// roundRobinSchedule returns the tables of each round of a round robin, in which each player meets
+// every other player.  Players meet at tables of two by the circle method, so that each pair meets
+// exactly once.  Larger tables are filled greedily, each round seating together the players having
+// the most pairs yet to meet, until every pair has met.
+func roundRobinSchedule(uids []int64, size int) [][][]int64 {
+	if size == 2 && len(uids)%2 == 0 {
+		return circleSchedule(uids)
+	}
+	return coveringSchedule(uids, size)
+}
+
+// circleSchedule pairs an even number of players: the first player keeps their place while the
+// others rotate around the circle, each player meeting the player across the circle.
+func circleSchedule(uids []int64) [][][]int64 {
+	n := len(uids)
+	if n < 2 {
+		return nil
+	}
+
+	ring := append([]int64{}, uids[1:]...)
+	rounds := make([][][]int64, n-1)
+	for r := range rounds {
+		seats := append([]int64{uids[0]}, ring...)
+		for i := 0; i < n/2; i++ {
+			rounds[r] = append(rounds[r], []int64{seats[i], seats[n-1-i]})
+		}
+		ring = append(ring[len(ring)-1:], ring[:len(ring)-1]...)
+	}
+	return rounds
+}
+
+// coveringSchedule seats players round after round until every pair has met.  Each table is seeded
+// by the player with the most players yet to meet, and filled by the players having met its
+// players the fewest times.  As the seed shares a table with a player they have yet to meet, each
+// round seats at least one new pair.
+func coveringSchedule(uids []int64, size int) [][][]int64 {
+	n := len(uids)
+	pairs := n * (n - 1) / 2
+	met := make(map[meetingKey]bool)
+	toMeet := func(uid int64) int {
+		count := 0
+		for _, other := range uids {
+			if other != uid && !met[newMeetingKey(uid, other)] {
+				count++
+			}
+		}
+		return count
+	}
+
+	var rounds [][][]int64
+	for len(met) < pairs && len(rounds) < maxTournamentRounds {
+		left := append([]int64{}, uids...)
+		var tables [][]int64
+		for _, tableSize := range tableSizes(n, size) {
+			seed := 0
+			for i, uid := range left {
+				if toMeet(uid) > toMeet(left[seed]) {
+					seed = i
+				}
+			}
+			table := []int64{left[seed]}
+			left = append(left[:seed], left[seed+1:]...)
+
+			for len(table) < tableSize {
+				best, bestMet, bestToMeet := 0, -1, -1
+				for i, uid := range left {
+					count := 0
+					for _, seated := range table {
+						if met[newMeetingKey(uid, seated)] {
+							count++
+						}
+					}
+					if bestMet == -1 || count < bestMet || (count == bestMet && toMeet(uid) > bestToMeet) {
+						best, bestMet, bestToMeet = i, count, toMeet(uid)
+					}
+				}
+				table = append(table, left[best])
+				left = append(left[:best], left[best+1:]...)
+			}
+			tables = append(tables, table)
+		}
+
+		for _, table := range tables {
+			for i, uid1 := range table {
+				for _, uid2 := range table[i+1:] {
+					met[newMeetingKey(uid1, uid2)] = true
+				}
+			}
+		}
+		rounds = append(rounds, tables)
+	}
+	return rounds
+}
+
+// seatingOrder returns the order in which players are seated for the next Swiss round: by
+// standings, after a random first round.
+func (t *Tournament) seatingOrder() []int64 {
+	order := make([]int64, len(t.UserIDS))
+	copy(order, t.UserIDS)
+
+	if len(t.Rounds) == 0 {
+		for i := len(order) - 1; i > 0; i-- {
+			j := sn.MyRand.Intn(i + 1)
+			order[i], order[j] = order[j], order[i]
+		}
+		return order
+	}
+
+	for i, s := range t.Standings() {
+		order[i] = s.UserID
+	}
+	return order
+}
+
+// swissTables seats the players of the next Swiss round.  Players are taken in seating order, each
+// table being filled by the players that have met its players the fewest times.
+func (t *Tournament) swissTables() [][]int64 {
+	order := t.seatingOrder()
+	ms := t.meetings()
+
+	var tables [][]int64
+	for _, size := range tableSizes(len(order), t.TableSize) {
+		table := []int64{order[0]}
+		order = order[1:]
+		for len(table) < size {
+			best, bestCount := 0, -1
+			for i, uid := range order {
+				count := 0
+				for _, seated := range table {
+					count += ms[newMeetingKey(uid, seated)]
+				}
+				if bestCount == -1 || count < bestCount {
+					best, bestCount = i, count
+				}
+			}
+			table = append(table, order[best])
+			order = append(order[:best], order[best+1:]...)
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// nextRound seats the players of the next round.  Round robin rounds follow the round robin
+// schedule, starting it over should the tournament have more rounds.
+func (t *Tournament) nextRound() *TournamentRound {
+	r := &TournamentRound{Number: len(t.Rounds) + 1}
+
+	var tables [][]int64
+	if t.Format == RoundRobinFormat {
+		if schedule := roundRobinSchedule(t.UserIDS, t.TableSize); len(schedule) > 0 {
+			tables = schedule[len(t.Rounds)%len(schedule)]
+		}
+	} else {
+		tables = t.swissTables()
+	}
+
+	for _, uids := range tables {
+		// Rotate seats, so players do not keep the same seat each round.
+		shift := (r.Number - 1) % len(uids)
+		table := &TournamentTable{UserIDS: append(append([]int64{}, uids[shift:]...), uids[:shift]...)}
+		r.Tables = append(r.Tables, table)
+	}
+
+	t.Rounds = append(t.Rounds, r)
+	t.Round = r.Number
+	return r
+}
+
+// recordResults records the places and scores of a completed tournament game.  It returns false,
+// if the game is not a game of the current round.
+func (t *Tournament) recordResults(g *Game) bool {
+	r := t.currentRound()
+	if r == nil {
+		return false
+	}
+
+	for _, table := range r.Tables {
+		if table.GameID != g.ID() || table.Completed {
+			continue
+		}
+
+		// Players are in order of finish following end game scoring.
+		var prev *Player
+		place := 0
+		for i, p := range g.Players() {
+			if prev == nil || p.compare(prev) != game.EqualTo || (i == 1 && g.HasVariant(AdmiralVariant)) {
+				place = i + 1
+			}
+			table.Results = append(table.Results, &TournamentResult{
+				UserID: p.User().ID(),
+				Place:  place,
+				Score:  p.Score,
+			})
+			prev = p
+		}
+		table.Completed = true
+		return true
+	}
+	return false
+}
+
+// TournamentStanding summarizes the results of a player.  Players earn a tournament point for each
+// player finishing below them in a game.
+type TournamentStanding struct {
+	Rank      int
+	UserID    int64
+	Name      string
+	Points    int
+	Wins      int
+	Score     int
+	Opponents int
+	Played    int
+}
+
+func (s *TournamentStanding) tieBreaker(id TieBreakerID) int {
+	switch id {
+	case WinsTieBreaker:
+		return s.Wins
+	case ScoreTieBreaker:
+		return s.Score
+	case OpponentsTieBreaker:
+		return s.Opponents
+	default:
+		return 0
+	}
+}
+
+// compare returns game.GreaterThan, if s ranks above s2.
+func (s *TournamentStanding) compare(s2 *TournamentStanding, tbs []TieBreakerID) game.Comparison {
+	if s.Points != s2.Points {
+		if s.Points > s2.Points {
+			return game.GreaterThan
+		}
+		return game.LessThan
+	}
+	for _, id := range tbs {
+		v1, v2 := s.tieBreaker(id), s2.tieBreaker(id)
+		if v1 > v2 {
+			return game.GreaterThan
+		}
+		if v1 < v2 {
+			return game.LessThan
+		}
+	}
+	return game.EqualTo
+}
+
+// Standings returns the standings of the players, ordered by tournament points and then by the
+// tie-breakers of the tournament.
+func (t *Tournament) Standings() []*TournamentStanding {
+	standings := make(map[int64]*TournamentStanding)
+	ss := make([]*TournamentStanding, len(t.UserIDS))
+	for i, uid := range t.UserIDS {
+		ss[i] = &TournamentStanding{UserID: uid, Name: t.nameFor(uid)}
+		standings[uid] = ss[i]
+	}
+
+	opponents := make(map[int64][]int64)
+	for _, r := range t.Rounds {
+		for _, table := range r.Tables {
+			for _, result := range table.Results {
+				s, ok := standings[result.UserID]
+				if !ok {
+					continue
+				}
+				s.Played += 1
+				s.Score += result.Score
+				s.Points += len(table.Results) - result.Place
+				if result.Place == 1 {
+					s.Wins += 1
+				}
+				for _, other := range table.Results {
+					if other.UserID != result.UserID {
+						opponents[result.UserID] = append(opponents[result.UserID], other.UserID)
+					}
+				}
+			}
+		}
+	}
+
+	for _, s := range ss {
+		for _, uid := range opponents[s.UserID] {
+			if o, ok := standings[uid]; ok {
+				s.Opponents += o.Points
+			}
+		}
+	}
+
+	sort.SliceStable(ss, func(i, j int) bool {
+		return ss[i].compare(ss[j], t.TieBreakers) == game.GreaterThan
+	})
+
+	for i, s := range ss {
+		s.Rank = i + 1
+		if i > 0 && s.compare(ss[i-1], t.TieBreakers) == game.EqualTo {
+			s.Rank = ss[i-1].Rank
+		}
+	}
+	return ss
+}
+
+// finalReport returns a plain text summary of the standings and of each round of the tournament.
+func (t *Tournament) finalReport() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n", t.Title)
+	fmt.Fprintf(&b, "%s tournament of %d rounds and %d players.\n", t.Format, len(t.Rounds), len(t.UserIDS))
+
+	var tbs []string
+	for _, id := range t.TieBreakers {
+		tbs = append(tbs, id.String())
+	}
+	if len(tbs) > 0 {
+		fmt.Fprintf(&b, "Ties broken by %s.\n", restful.ToSentence(tbs))
+	}
+
+	fmt.Fprintf(&b, "\nFinal Standings\n")
+	for _, s := range t.Standings() {
+		fmt.Fprintf(&b, "%d. %s: %d %s (wins %d, score %d, opponents' points %d)\n",
+			s.Rank, s.Name, s.Points, pluralize("point", s.Points), s.Wins, s.Score, s.Opponents)
+	}
+
+	for _, r := range t.Rounds {
+		fmt.Fprintf(&b, "\nRound %d\n", r.Number)
+		for i, table := range r.Tables {
+			var results []string
+			for _, result := range table.Results {
+				results = append(results, fmt.Sprintf("%d. %s (%d)", result.Place, t.nameFor(result.UserID), result.Score))
+			}
+			fmt.Fprintf(&b, "Table %d, Game #%d: %s\n", i+1, table.GameID, strings.Join(results, ", "))
+		}
+	}
+	return b.String()
+}
+
+func (t *Tournament) fromForm(c *gin.Context, cu *user.User) error {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	obj := struct {
+		Title       string  `form:"title"`
+		Format      int     `form:"format" binding:"min=0"`
+		TableSize   int     `form:"table-size" binding:"min=0,max=5"`
+		Rounds      int     `form:"rounds" binding:"min=0"`
+		UserIDS     []int64 `form:"user-ids"`
+		Variants    []int   `form:"variants"`
+		TieBreakers []int   `form:"tie-breakers"`
+	}{}
+
+	err := c.ShouldBind(&obj)
+	if err != nil {
+		return err
+	}
+
+	t.Title = cu.Name + "'s Tournament"
+	if obj.Title != "" {
+		t.Title = obj.Title
+	}
+
+	t.Format = TournamentFormat(obj.Format)
+	t.TableSize = 4
+	if obj.TableSize != 0 {
+		t.TableSize = obj.TableSize
+	}
+	t.CreatorID = cu.ID()
+	t.UserIDS = obj.UserIDS
+
+	g := &Game{State: newState()}
+	g.setVariants(variantsFromForm(obj.Variants, false, false))
+	t.Variants = g.Variants
+
+	t.TieBreakers = defaultTieBreakers
+	if len(obj.TieBreakers) > 0 {
+		t.TieBreakers = nil
+		for _, id := range obj.TieBreakers {
+			t.TieBreakers = append(t.TieBreakers, TieBreakerID(id))
+		}
+	}
+
+	t.NumRounds = obj.Rounds
+	if t.NumRounds == 0 {
+		t.NumRounds = t.defaultRounds()
+	}
+	return t.validate(localeFrom(c))
+}
+
+func getTournamentID(c *gin.Context) (int64, error) {
+	id, err := strconv.ParseInt(c.Param("tid"), 10, 64)
+	if err != nil {
+		return -1, ErrInvalidID
+	}
+	return id, nil
+}
+
+func tournamentPath(prefix string, id int64) string {
+	return fmt.Sprintf("/%s/tournament/%d/json", prefix, id)
+}
+
+// usersFor returns the users of the tournament and its creator, keyed by id.
+func (client *Client) usersFor(c *gin.Context, t *Tournament) (map[int64]*user.User, error) {
+	ids := append([]int64{t.CreatorID}, t.UserIDS...)
+	us, err := client.User.GetMulti(c, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[int64]*user.User, len(us))
+	for _, u := range us {
+		users[u.ID()] = u
+	}
+	return users, nil
+}
+
+// newTournamentGames creates and starts a game for each table of the round, seating the
+// players in table order.  The game ids are recorded on the tables.
+func (client *Client) newTournamentGames(c *gin.Context, t *Tournament, r *TournamentRound,
+	users map[int64]*user.User) ([]*Game, error) {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	var gs []*Game
+	for i, table := range r.Tables {
+		g := New(c, 0)
+		g.Title = fmt.Sprintf("%s: Round %d, Table %d", t.Title, r.Number, i+1)
+		g.NumPlayers = len(table.UserIDS)
+		g.setVariants(t.Variants)
+		g.TournamentID = t.ID()
+		g.AddCreator(users[t.CreatorID])
+		for _, uid := range table.UserIDS {
+			u, ok := users[uid]
+			if !ok {
+				return nil, fmt.Errorf("user %d not found", uid)
+			}
+			g.AddUser(u)
+		}
+
+		err := g.Start(c)
+		if err != nil {
+			return nil, err
+		}
+
+		err = g.encode(c)
+		if err != nil {
+			return nil, err
+		}
+
+		ks, err := client.DS.AllocateIDs(c, []*datastore.Key{g.Key})
+		if err != nil {
+			return nil, err
+		}
+
+		g.Key = ks[0]
+		table.GameID = g.ID()
+		gs = append(gs, g)
+	}
+	return gs, nil
+}
+
+// withGames appends the keys and entities needed to save new games, and their message logs.
+func withGames(ks []*datastore.Key, es []interface{}, gs []*Game) ([]*datastore.Key, []interface{}) {
+	for _, g := range gs {
+		m := mlog.New(g.ID())
+		ks = append(ks, m.Key, g.Key)
+		es = append(es, m, g.Header)
+	}
+	return ks, es
+}
+
+// sendTournamentNotifications notifies the first players of newly created games.
+func sendTournamentNotifications(c *gin.Context, gs []*Game) {
+	for _, g := range gs {
+		err := g.SendTurnNotificationsTo(c, g.CurrentPlayer())
+		if err != nil {
+			log.Warningf(err.Error())
+		}
+	}
+}
+
+func (client *Client) createTournament(prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client.Log.Debugf(msgEnter)
+		defer client.Log.Debugf(msgExit)
+
+		cu, err := client.User.Current(c)
+		if err != nil || cu == nil {
+			restful.AddErrorf(c, "%s", localeFrom(c).Sprintf("error.must-be-logged-in-create-tournament"))
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		// A tournament seats its players in games without their consent, so only admins create them.
+		if !cu.IsAdmin() {
+			restful.AddErrorf(c, "%s", localeFrom(c).Sprintf("error.only-admins-may-create-tournaments"))
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		t := newTournament(c, 0)
+		err = t.fromForm(c, cu)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			restful.AddErrorf(c, err.Error())
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		users, err := client.usersFor(c, t)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			restful.AddErrorf(c, err.Error())
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		for _, uid := range t.UserIDS {
+			t.UserNames = append(t.UserNames, users[uid].Name)
+		}
+
+		tks, err := client.DS.AllocateIDs(c, []*datastore.Key{t.Key})
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+		t.Key = tks[0]
+		t.CreatedAt = time.Now()
+
+		gs, err := client.newTournamentGames(c, t, t.nextRound(), users)
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			restful.AddErrorf(c, err.Error())
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		err = t.encode()
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		_, err = client.DS.RunInTransaction(c, func(tx *datastore.Transaction) error {
+			ks, es := withGames([]*datastore.Key{t.Key}, []interface{}{t}, gs)
+			_, err := tx.PutMulti(ks, es)
+			return err
+		})
+		if err != nil {
+			client.Log.Errorf(err.Error())
+			c.Redirect(http.StatusSeeOther, recruitingPath(prefix))
+			return
+		}
+
+		for _, g := range gs {
+			client.dispatch(c, g)
+		}
+		sendTournamentNotifications(c, gs)
+
+		restful.AddNoticef(c, "<div>%s</div>", localeFrom(c).Sprintf("notice.tournament-created", t.Title))
+		c.Redirect(http.StatusSeeOther, tournamentPath(prefix, t.ID()))
+	}
+}
+
+func (client *Client) getTournament(c *gin.Context, id int64) (*Tournament, error) {
+	t := newTournament(c, id)
+	err := client.DS.Get(c, t.Key, t)
+	if err != nil {
+		return nil, err
+	}
+	return t, t.decode()
+}
+
+// tournamentAdvance records the results of a completed tournament game.  Once all games of the
+// round are over, the games of the next round are created, or the tournament is completed.
+type tournamentAdvance struct {
+	client *Client
+	c      *gin.Context
+	g      *Game
+	users  map[int64]*user.User
+
+	// games are the games of the next round, created by the last run of put.
+	games []*Game
+}
+
+// newTournamentAdvance returns the advance of the tournament of the completed game, or nil if the
+// game is not part of a tournament.
+func (client *Client) newTournamentAdvance(c *gin.Context, g *Game) (*tournamentAdvance, error) {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	if g.TournamentID == 0 {
+		return nil, nil
+	}
+
+	t, err := client.getTournament(c, g.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := client.usersFor(c, t)
+	if err != nil {
+		return nil, err
+	}
+	return &tournamentAdvance{client: client, c: c, g: g, users: users}, nil
+}
+
+// put returns the tournament, and the games of its next round, to be put by the transaction saving
+// the completed game.
+func (a *tournamentAdvance) put(tx *datastore.Transaction) ([]*datastore.Key, []interface{}, error) {
+	a.games = nil
+	t := newTournament(a.c, a.g.TournamentID)
+	err := tx.Get(t.Key, t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = t.decode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !t.recordResults(a.g) {
+		return nil, nil, nil
+	}
+
+	var gs []*Game
+	switch {
+	case !t.currentRound().completed():
+	case t.Round >= t.NumRounds:
+		t.Status = TournamentCompleted
+		t.Report = t.finalReport()
+	default:
+		gs, err = a.client.newTournamentGames(a.c, t, t.nextRound(), a.users)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = t.encode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ks, es := withGames([]*datastore.Key{t.Key}, []interface{}{t}, gs)
+	a.games = gs
+	return ks, es, nil
+}
+
+// notify dispatches the events of the games of the next round and notifies their first players,
+// once the transaction is committed.
+func (a *tournamentAdvance) notify() {
+	for _, g := range a.games {
+		a.client.dispatch(a.c, g)
+	}
+	sendTournamentNotifications(a.c, a.games)
+}
+
+func (client *Client) showTournament(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	id, err := getTournamentID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	t, err := client.getTournament(c, id)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"ID":          t.ID(),
+		"Title":       t.Title,
+		"Status":      t.Status.String(),
+		"Format":      t.Format.String(),
+		"Round":       t.Round,
+		"NumRounds":   t.NumRounds,
+		"Rounds":      t.Rounds,
+		"TieBreakers": t.TieBreakers,
+		"Standings":   t.Standings(),
+	})
+}
+
+func (client *Client) tournamentReport(c *gin.Context) {
+	client.Log.Debugf(msgEnter)
+	defer client.Log.Debugf(msgExit)
+
+	id, err := getTournamentID(c)
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	t, err := client.getTournament(c, id)
+	if err != nil {
+		client.Log.Errorf(err.Error())
+		c.AbortWithError(http.StatusNotFound, err)
+		return
+	}
+
+	report := t.Report
+	if t.Status != TournamentCompleted {
+		report = t.finalReport()
+	}
+	c.String(http.StatusOK, report)
+}
@@ -0,0 +1,190 @@
+package confucius
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testUserIDS(n int) []int64 {
+	uids := make([]int64, n)
+	for i := range uids {
+		uids[i] = int64(i + 1)
+	}
+	return uids
+}
+
+func TestRoundRobinMeetings(t *testing.T) {
+	for size := 2; size <= 5; size++ {
+		for n := size; n <= 16; n++ {
+			if size == 2 && n%2 == 1 {
+				continue // Tables of one player are refused by validate.
+			}
+			t.Run(fmt.Sprintf("%d players at tables of %d", n, size), func(t *testing.T) {
+				tn := &Tournament{
+					UserIDS:         testUserIDS(n),
+					TournamentState: &TournamentState{Format: RoundRobinFormat, TableSize: size},
+				}
+				rounds := tn.defaultRounds()
+				if lower := (n - 1 + size - 2) / (size - 1); rounds < lower {
+					t.Errorf("rounds: got %d, want at least %d", rounds, lower)
+				}
+				if rounds > maxTournamentRounds {
+					t.Errorf("rounds: got %d, want at most %d", rounds, maxTournamentRounds)
+				}
+
+				for i := 0; i < rounds; i++ {
+					r := tn.nextRound()
+					seated := make(map[int64]int)
+					for _, table := range r.Tables {
+						for _, uid := range table.UserIDS {
+							seated[uid]++
+						}
+					}
+					for _, uid := range tn.UserIDS {
+						if seated[uid] != 1 {
+							t.Fatalf("round %d: player %d seated %d times", r.Number, uid, seated[uid])
+						}
+					}
+				}
+
+				ms := tn.meetings()
+				for i, uid1 := range tn.UserIDS {
+					for _, uid2 := range tn.UserIDS[i+1:] {
+						count := ms[newMeetingKey(uid1, uid2)]
+						if count == 0 {
+							t.Errorf("players %d and %d never meet", uid1, uid2)
+						}
+						if size == 2 && count > 1 {
+							t.Errorf("players %d and %d meet %d times", uid1, uid2, count)
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestDefaultRounds(t *testing.T) {
+	tests := []struct {
+		format TournamentFormat
+		n      int
+		size   int
+		want   int
+	}{
+		{SwissFormat, 12, 4, defaultSwissRounds},
+		{RoundRobinFormat, 8, 2, 7},
+		{RoundRobinFormat, 4, 4, 1},
+		{RoundRobinFormat, 2, 5, 1},
+	}
+
+	for _, tt := range tests {
+		tn := &Tournament{
+			UserIDS:         testUserIDS(tt.n),
+			TournamentState: &TournamentState{Format: tt.format, TableSize: tt.size},
+		}
+		if got := tn.defaultRounds(); got != tt.want {
+			t.Errorf("%v, %d players at tables of %d: got %d rounds, want %d", tt.format, tt.n, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestSwissRoundSeatsByStandings(t *testing.T) {
+	tn := &Tournament{
+		UserIDS:         testUserIDS(4),
+		TournamentState: &TournamentState{Format: SwissFormat, TableSize: 2, TieBreakers: defaultTieBreakers},
+	}
+	tn.Rounds = []*TournamentRound{{Number: 1, Tables: []*TournamentTable{
+		{UserIDS: []int64{1, 2}, Completed: true, Results: []*TournamentResult{{UserID: 1, Place: 1}, {UserID: 2, Place: 2}}},
+		{UserIDS: []int64{3, 4}, Completed: true, Results: []*TournamentResult{{UserID: 4, Place: 1}, {UserID: 3, Place: 2}}},
+	}}}
+
+	// The winners, having met no one but the losers, are seated together.
+	r := tn.nextRound()
+	for _, table := range r.Tables {
+		ms := map[int64]bool{}
+		for _, uid := range table.UserIDS {
+			ms[uid] = true
+		}
+		if ms[1] != ms[4] {
+			t.Errorf("winners not seated together: %v", table.UserIDS)
+		}
+	}
+}
+
+func TestStandings(t *testing.T) {
+	tests := []struct {
+		name        string
+		tieBreakers []TieBreakerID
+		tables      []*TournamentTable
+		wantOrder   []int64
+		wantRanks   []int
+		wantPoints  []int
+	}{
+		{
+			name:        "points",
+			tieBreakers: defaultTieBreakers,
+			tables: []*TournamentTable{
+				{Results: []*TournamentResult{{UserID: 3, Place: 1, Score: 20}, {UserID: 1, Place: 2, Score: 15}, {UserID: 2, Place: 3, Score: 10}}},
+			},
+			wantOrder:  []int64{3, 1, 2},
+			wantRanks:  []int{1, 2, 3},
+			wantPoints: []int{2, 1, 0},
+		},
+		{
+			name:        "wins break ties",
+			tieBreakers: defaultTieBreakers,
+			tables: []*TournamentTable{
+				{Results: []*TournamentResult{{UserID: 1, Place: 1, Score: 10}, {UserID: 2, Place: 2, Score: 30}}},
+				{Results: []*TournamentResult{{UserID: 3, Place: 1, Score: 10}, {UserID: 4, Place: 2, Score: 5}}},
+				{Results: []*TournamentResult{{UserID: 2, Place: 1, Score: 30}, {UserID: 3, Place: 2, Score: 30}, {UserID: 1, Place: 3, Score: 1}}},
+			},
+			// 1: 1 point, 1 win; 2: 2 points, 1 win; 3: 2 points, 1 win; 4: no points.
+			// 2 and 3 tie on wins, so score breaks the tie: 60 against 40.
+			wantOrder:  []int64{2, 3, 1, 4},
+			wantRanks:  []int{1, 2, 3, 4},
+			wantPoints: []int{2, 2, 1, 0},
+		},
+		{
+			name:        "tie-breakers in the given order",
+			tieBreakers: []TieBreakerID{OpponentsTieBreaker},
+			tables: []*TournamentTable{
+				{Results: []*TournamentResult{{UserID: 1, Place: 1, Score: 50}, {UserID: 2, Place: 2}}},
+				{Results: []*TournamentResult{{UserID: 3, Place: 1, Score: 10}, {UserID: 4, Place: 2}}},
+				{Results: []*TournamentResult{{UserID: 4, Place: 1}, {UserID: 5, Place: 2}}},
+			},
+			// 1, 3 and 4 have a point each.  3 and 4 met each other, and 4 has a point, while 1 met
+			// only 2, who has none.  Score, not a tie-breaker here, does not favour 1.
+			wantOrder:  []int64{3, 4, 1, 2, 5},
+			wantRanks:  []int{1, 1, 3, 4, 4},
+			wantPoints: []int{1, 1, 1, 0, 0},
+		},
+		{
+			name:        "equal players share a rank",
+			tieBreakers: defaultTieBreakers,
+			tables: []*TournamentTable{
+				{Results: []*TournamentResult{{UserID: 1, Place: 1, Score: 10}, {UserID: 2, Place: 1, Score: 10}, {UserID: 3, Place: 3}}},
+			},
+			wantOrder:  []int64{1, 2, 3},
+			wantRanks:  []int{1, 1, 3},
+			wantPoints: []int{2, 2, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tn := &Tournament{
+				UserIDS:         testUserIDS(len(tt.wantOrder)),
+				TournamentState: &TournamentState{TieBreakers: tt.tieBreakers},
+			}
+			tn.Rounds = []*TournamentRound{{Number: 1, Tables: tt.tables}}
+
+			ss := tn.Standings()
+			for i, s := range ss {
+				if s.UserID != tt.wantOrder[i] || s.Rank != tt.wantRanks[i] || s.Points != tt.wantPoints[i] {
+					t.Errorf("standing %d: got player %d, rank %d, %d points; want player %d, rank %d, %d points",
+						i+1, s.UserID, s.Rank, s.Points, tt.wantOrder[i], tt.wantRanks[i], tt.wantPoints[i])
+				}
+			}
+		})
+	}
+}
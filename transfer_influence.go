@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -54,6 +53,7 @@ func (p *Player) newTransferInfluenceEntry(op *Player, m *Ministry, o *OfficialT
 	e.OtherPlayerID = op.ID()
 	e.MinistryName = m.Name()
 	e.Seniority = o.Seniority
+	e.Gift = gift
 
 	p.Log = append(p.Log, e)
 	g.Log = append(g.Log, e)
@@ -61,10 +61,22 @@ func (p *Player) newTransferInfluenceEntry(op *Player, m *Ministry, o *OfficialT
 }
 
 func (e *transferInfluenceEntry) HTML() template.HTML {
+	l := e.locale()
 	if e.Gift != nil && e.Gift.Value > 0 {
-		return restful.HTML("%s transferred influence on %s official with level %d seniority to %s, and removed %s gift of %s from game.", e.Player().Name(), e.MinistryName, e.Seniority, e.OtherPlayer().Name(), e.Gift.Name(), e.OtherPlayer().Name())
+		return l.HTML("entry.transfer-influence-gift", e.Player().Name(), l.Name(e.MinistryName), e.Seniority, e.OtherPlayer().Name(), l.Name(e.Gift.Name()), e.OtherPlayer().Name())
 	}
-	return restful.HTML("%s transferred influence on %s official with level %d seniority to %s.", e.Player().Name(), e.MinistryName, e.Seniority, e.OtherPlayer().Name())
+	return l.HTML("entry.transfer-influence", e.Player().Name(), l.Name(e.MinistryName), e.Seniority, e.OtherPlayer().Name())
+}
+
+func (e *transferInfluenceEntry) influenceChanges() map[int]int {
+	return map[int]int{e.PlayerID: -1, e.OtherPlayerID: 1}
+}
+
+func (e *transferInfluenceEntry) obligationChanges() []*obligationChange {
+	if e.Gift == nil || e.Gift.Value == 0 {
+		return nil
+	}
+	return []*obligationChange{{debtorID: e.PlayerID, creditorID: e.OtherPlayerID, gift: e.Gift.Name(), canceled: true}}
 }
 
 func (g *Game) validateTransferInfluence(c *gin.Context, cu *user.User) (*Ministry, *OfficialTile, *Player, error) {
@@ -86,23 +98,38 @@ func (g *Game) validateTransferInfluence(c *gin.Context, cu *user.User) (*Minist
 
 	switch {
 	case official.Player() == nil:
-		return nil, nil, nil, sn.NewVError("You don't have influence over the official having seniority level %d in the %s ministry.", official.Seniority, ministry.Name())
+		return nil, nil, nil, g.vError("error.dont-influence-over-official-having-seniority", official.Seniority, ministry.Name())
 	case official.Player().NotEqual(cp):
-		return nil, nil, nil, sn.NewVError("You don't have influence over the official having seniority level %d in the %s ministry.", official.Seniority, ministry.Name())
+		return nil, nil, nil, g.vError("error.dont-influence-over-official-having-seniority", official.Seniority, ministry.Name())
 	case ministry.Resolved:
-		return nil, nil, nil, sn.NewVError("You can't transfer influence in a resolved ministry.")
+		return nil, nil, nil, g.vError("error.cant-transfer-influence-resolved-ministry")
 	}
 	return ministry, official, player, nil
 }
 
 func (g *Game) EnableTransferInfluence(cu *user.User) bool {
-	cp := g.CurrentPlayer()
-	return g.IsCurrentPlayer(cu) && cp.canTransferInfluence()
+	return g.TransferInfluenceReasons(cu).None()
+}
+
+// TransferInfluenceReasons returns why the user cu may not transfer influence.
+func (g *Game) TransferInfluenceReasons(cu *user.User) Reasons {
+	cp, r := g.checkTurn(cu)
+	if cp == nil {
+		return r.reasons
+	}
+	return cp.transferInfluenceReasons()
+}
+
+// transferInfluenceReasons returns why the player may not transfer influence, whoever is asking.
+func (p *Player) transferInfluenceReasons() Reasons {
+	r := &reasonCheck{g: p.Game()}
+	r.actionPhase().notPerformed(p).
+		require(p.hasInfluenceToTransfer(), "reason.no-influence-to-transfer")
+	return r.reasons
 }
 
 func (p *Player) canTransferInfluence() bool {
-	g := p.Game()
-	return g.inActionsOrImperialFavourPhase() && !p.PerformedAction && p.hasInfluenceToTransfer()
+	return p.transferInfluenceReasons().None()
 }
 
 func (p *Player) hasInfluenceToTransfer() bool {
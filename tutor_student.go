@@ -7,7 +7,6 @@ import (
 	"github.com/SlothNinja/game"
 	"github.com/SlothNinja/log"
 	"github.com/SlothNinja/restful"
-	"github.com/SlothNinja/sn"
 	"github.com/SlothNinja/user"
 	"github.com/gin-gonic/gin"
 )
@@ -85,22 +84,30 @@ func (p *Player) newTutorStudentEntry(cards ConCards, player *Player, canceled,
 }
 
 func (e *tutorStudentEntry) HTML() template.HTML {
+	l := e.locale()
 	op := e.OtherPlayer()
 	switch length := len(e.Played); {
 	case e.OtherPlayer() != nil && !e.CancelGift:
 		if e.Auto {
-			return restful.HTML("%s auto-spent %d %s to tutor student of %s.",
-				e.Player().Name(), length, pluralize("card", length), op.Name())
+			return l.HTML("entry.auto-tutor-student",
+				e.Player().Name(), length, l.Plural("card", length), op.Name())
 		} else {
-			return restful.HTML("%s spent %d %s to tutor student of %s.",
-				e.Player().Name(), length, pluralize("card", length), op.Name())
+			return l.HTML("entry.tutor-student",
+				e.Player().Name(), length, l.Plural("card", length), op.Name())
 		}
 	case e.OtherPlayer() != nil && e.CancelGift:
 		name := op.Name()
-		return restful.HTML("%s spent %d %s to tutor student of %s and canceled gift received from %s.",
-			e.Player().Name(), length, pluralize("card", length), name, name)
+		return l.HTML("entry.tutor-student-cancel",
+			e.Player().Name(), length, l.Plural("card", length), name, name)
 	}
-	return restful.HTML("%s has no cards to tutor a student.", e.Player().Name())
+	return l.HTML("entry.tutor-student-no-cards", e.Player().Name())
+}
+
+func (e *tutorStudentEntry) obligationChanges() []*obligationChange {
+	if !e.CancelGift {
+		return nil
+	}
+	return []*obligationChange{{debtorID: e.PlayerID, creditorID: e.OtherPlayerID, canceled: true}}
 }
 
 func (g *Game) validateTutorStudent(c *gin.Context, cu *user.User) (ConCards, *Player, error) {
@@ -117,13 +124,13 @@ func (g *Game) validateTutorStudent(c *gin.Context, cu *user.User) (ConCards, *P
 
 	switch {
 	case !cp.TutorPlayers().Include(p):
-		return nil, nil, sn.NewVError("You provided an incorrect player.")
+		return nil, nil, g.vError("error.provided-incorrect-player")
 	case !g.IsCurrentPlayer(cu):
-		return nil, nil, sn.NewVError("Only the current player may pay to tutor a student.")
+		return nil, nil, g.vError("error.only-current-player-may-pay-tutor")
 	case g.Phase != ImperialExamination:
-		return nil, nil, sn.NewVError("You cannot pay to tutor a student during the %s phase.", g.PhaseName())
+		return nil, nil, g.vError("error.cannot-pay-tutor-student-during-phase", g.PhaseName())
 	case len(cds) < 1 && len(cp.ConCardHand) > 0:
-		return nil, nil, sn.NewVError("You must play at least one Confucius Card.")
+		return nil, nil, g.vError("error.must-play-at-least-one-confucius")
 	default:
 		return cds, p, nil
 	}
@@ -201,7 +208,9 @@ func (g *Game) tutorStudentsPhaseFinishTurn(c *gin.Context, cu *user.User) (*use
 		g.SetCurrentPlayerers(p)
 		return s, nil
 	}
-	g.resolveExamination()
+	if err := g.resolveExamination(); err != nil {
+		return nil, err
+	}
 	return s, nil
 }
 
@@ -0,0 +1,292 @@
+package confucius
+
+import (
+	"encoding/gob"
+	"html/template"
+
+	"github.com/SlothNinja/color"
+	"github.com/SlothNinja/game"
+	"github.com/SlothNinja/log"
+)
+
+func init() {
+	gob.RegisterName("*game.neutralBribeEntry", new(neutralBribeEntry))
+	gob.RegisterName("*game.neutralNominateEntry", new(neutralNominateEntry))
+	gob.RegisterName("*game.neutralTutorEntry", new(neutralTutorEntry))
+}
+
+// The neutral family of the two player rules is not among the game's Playerers, so it never takes
+// a turn, receives titles or places.  It is identified by NeutralPlayerID, which lies outside the
+// range of ids used by the players of a five player game.
+const (
+	NeutralPlayerID = 5
+	neutralColor    = color.Black
+
+	// Number of Confucius cards drawn to tutor the neutral student in a contested examination.
+	neutralTutorCards = 2
+)
+
+func (p *Player) isNeutral() bool {
+	return p != nil && p.ID() == NeutralPlayerID
+}
+
+// Name overrides game.Player#Name, which indexes the game's users and so cannot name the neutral family.
+func (p *Player) Name() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.isNeutral():
+		return p.Game().neutralName()
+	default:
+		return p.Player.Name()
+	}
+}
+
+// PlayererByID overrides game.Header#PlayererByID so log entries and officials resolve the neutral family.
+func (g *Game) PlayererByID(id int) game.Playerer {
+	if id == NeutralPlayerID && g.Neutral != nil {
+		return g.Neutral
+	}
+	if p := g.Header.PlayererByID(id); p != nil {
+		return p
+	}
+	return nil
+}
+
+func (g *Game) NameFor(p game.Playerer) string {
+	if p != nil {
+		return g.NameByPID(p.ID())
+	}
+	return ""
+}
+
+func (g *Game) NameByPID(pid int) string {
+	if pid == NeutralPlayerID && g.Neutral != nil {
+		return g.neutralName()
+	}
+	return g.Header.NameByPID(pid)
+}
+
+// neutralName returns the name of the neutral family, which represents the Emperor's court in solo games.
+func (g *Game) neutralName() string {
+	if g.HasVariant(SoloVariant) {
+		return "Emperor's Court"
+	}
+	return "Neutral Family"
+}
+
+func (g *Game) createNeutralFamily() {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	p := NewPlayer()
+	p.SetID(NeutralPlayerID)
+	p.SetGame(g)
+	p.SetColorMap(color.Colors{neutralColor})
+	p.NewGiftCardHand()
+	p.NewGiftsBought()
+	g.Neutral = p
+
+	// The neutral family begins with one official in each ministry.
+	for _, mid := range ministeryIDS {
+		if official := g.Ministries[mid].cheapestUnbribedOfficial(); official != nil {
+			g.neutralBribe(g.Ministries[mid], official)
+		}
+	}
+}
+
+// neutralFamilyRound performs the actions of the neutral family at the start of each round:
+// it bribes the cheapest unbribed official of an unresolved ministry, and it nominates a student
+// if the candidate has room for one.
+func (g *Game) neutralFamilyRound() {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	if g.Neutral == nil {
+		return
+	}
+
+	var ministry *Ministry
+	var official *OfficialTile
+	for _, mid := range ministeryIDS {
+		m := g.Ministries[mid]
+		if o := m.cheapestUnbribedOfficial(); o != nil && (official == nil || o.Cost < official.Cost) {
+			ministry, official = m, o
+		}
+	}
+
+	if official != nil {
+		g.neutralBribe(ministry, official)
+	}
+
+	g.neutralNominate()
+}
+
+// cheapestUnbribedOfficial returns the cheapest unbribed official of an unresolved ministry,
+// breaking ties in favour of the more senior official.
+func (m *Ministry) cheapestUnbribedOfficial() *OfficialTile {
+	if m.Resolved {
+		return nil
+	}
+
+	var official *OfficialTile
+	for _, seniority := range m.Game().Seniorities() {
+		o, ok := m.Officials[seniority]
+		if ok && o.NotBribed() && (official == nil || o.Cost < official.Cost) {
+			official = o
+		}
+	}
+	return official
+}
+
+func (g *Game) neutralBribe(m *Ministry, o *OfficialTile) {
+	o.setPlayer(g.Neutral)
+	g.Neutral.newNeutralBribeEntry(m, o)
+}
+
+type neutralBribeEntry struct {
+	*Entry
+	MinistryName string
+	Seniority    Seniority
+}
+
+func (p *Player) newNeutralBribeEntry(m *Ministry, o *OfficialTile) *neutralBribeEntry {
+	g := p.Game()
+	e := new(neutralBribeEntry)
+	e.Entry = p.newEntry()
+	e.MinistryName = m.Name()
+	e.Seniority = o.Seniority
+	p.Log = append(p.Log, e)
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *neutralBribeEntry) HTML() template.HTML {
+	l := e.locale()
+	return l.HTML("entry.neutral-bribe",
+		e.Player().Name(), e.Seniority, l.Name(e.MinistryName))
+}
+
+func (g *Game) neutralNominate() {
+	can := g.Candidate()
+	switch {
+	case g.Round == 1, can == nil, !can.Playable(), can.hasTwoPlayers():
+		return
+	case g.Neutral.Equal(can.Player()), g.Neutral.Equal(can.OtherPlayer()):
+		return
+	case can.hasOnePlayer():
+		can.setOtherPlayer(g.Neutral)
+	default:
+		can.setPlayer(g.Neutral)
+	}
+	g.Neutral.newNeutralNominateEntry()
+}
+
+type neutralNominateEntry struct {
+	*Entry
+}
+
+func (p *Player) newNeutralNominateEntry() *neutralNominateEntry {
+	g := p.Game()
+	e := new(neutralNominateEntry)
+	e.Entry = p.newEntry()
+	p.Log = append(p.Log, e)
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *neutralNominateEntry) HTML() template.HTML {
+	return e.locale().HTML("entry.nominate-student", e.Player().Name())
+}
+
+// neutralTutor tutors the neutral student of a contested examination with cards drawn from the deck.
+func (g *Game) neutralTutor(can *CandidateTile) {
+	if g.Neutral == nil || !can.hasTwoPlayers() {
+		return
+	}
+
+	var cards ConCards
+	for i := 0; i < neutralTutorCards; i++ {
+		if card := g.DrawConCard(); card != nil {
+			cards = append(cards, card)
+		}
+	}
+	cards.Reveal()
+
+	switch {
+	case g.Neutral.Equal(can.Player()):
+		can.PlayerCards.Append(cards...)
+	case g.Neutral.Equal(can.OtherPlayer()):
+		can.OtherPlayerCards.Append(cards...)
+	default:
+		g.ConDiscardPile.Append(cards...)
+		return
+	}
+	g.Neutral.newNeutralTutorEntry(cards)
+}
+
+type neutralTutorEntry struct {
+	*Entry
+	Played ConCards
+}
+
+func (p *Player) newNeutralTutorEntry(cards ConCards) *neutralTutorEntry {
+	g := p.Game()
+	e := new(neutralTutorEntry)
+	e.Entry = p.newEntry()
+	e.Played = cards
+	p.Log = append(p.Log, e)
+	g.Log = append(g.Log, e)
+	return e
+}
+
+func (e *neutralTutorEntry) HTML() template.HTML {
+	l := e.locale()
+	length := len(e.Played)
+	return l.HTML("entry.neutral-tutor",
+		e.Player().Name(), length, l.Plural("card", length), e.Played.Coins())
+}
+
+// neutralPlaceStudent places the promoted neutral student in the first available spot.  The Chief
+// Minister then finishes the turn on behalf of the neutral family.
+func (g *Game) neutralPlaceStudent() {
+	log.Debugf(msgEnter)
+	defer log.Debugf(msgExit)
+
+	ms := g.MinistriesFor(g.Candidate())
+
+	var ministry *Ministry
+	var seniority Seniority
+	for _, mid := range ministeryIDS {
+		m, ok := ms[mid]
+		if !ok {
+			continue
+		}
+		spots := m.emptyCandidateSpots()
+		if len(spots) == 0 {
+			spots = m.unbribedUnsecuredCandidateSpots()
+		}
+		if len(spots) > 0 {
+			ministry, seniority = m, spots[0]
+			break
+		}
+	}
+
+	g.Neutral.placeStudentIn(ministry, seniority)
+
+	if cm := g.ChiefMinister(); cm != nil {
+		g.SetCurrentPlayerers(cm)
+		cm.PerformedAction = true
+	}
+}
+
+// neutralTransferTarget returns the player to whom the neutral family temporarily transfers its
+// influence: the player having the most influence in the ministry, ties going to the player
+// holding the more senior official.
+func (m *Ministry) neutralTransferTarget(ps Players) *Player {
+	ids := make([]int, len(ps))
+	for i, p := range ps {
+		ids[i] = p.ID()
+	}
+	return m.Game().PlayerByID(m.tempInfluence().neutralTarget(ids))
+}
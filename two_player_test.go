@@ -0,0 +1,333 @@
+package confucius
+
+import "testing"
+
+func TestCreateNeutralFamily(t *testing.T) {
+	_, g := newTestGame(t, 2, TwoPlayerVariant)
+
+	if g.Neutral == nil {
+		t.Fatal("no neutral family")
+	}
+
+	// The neutral family begins with one official in each ministry, and bribes another in the
+	// first round.
+	if got, want := neutralOfficials(g), len(ministeryIDS)+1; got != want {
+		t.Errorf("neutral officials: got %d, want %d", got, want)
+	}
+	for _, mid := range ministeryIDS {
+		m := g.Ministries[mid]
+		held := false
+		for _, id := range holdersOf(m) {
+			held = held || id == NeutralPlayerID
+		}
+		if !held {
+			t.Errorf("%s: neutral family holds no official", m.Name())
+		}
+	}
+}
+
+func TestNeutralFamilyRoundBribes(t *testing.T) {
+	tests := []struct {
+		name      string
+		costs     map[MinistryID]map[Seniority]int
+		bribed    map[MinistryID]map[Seniority]int
+		resolved  []MinistryID
+		ministry  MinistryID
+		seniority Seniority
+		none      bool
+	}{
+		{
+			name: "cheapest official of any ministry",
+			costs: map[MinistryID]map[Seniority]int{
+				Bingbu: {3: 3, 4: 3},
+				Hubu:   {3: 2, 5: 1},
+				Gongbu: {3: 2, 4: 2},
+			},
+			ministry:  Hubu,
+			seniority: 5,
+		},
+		{
+			name: "tie goes to the more senior official",
+			costs: map[MinistryID]map[Seniority]int{
+				Bingbu: {3: 2, 4: 2},
+				Hubu:   {3: 1, 5: 1},
+				Gongbu: {3: 2, 4: 2},
+			},
+			ministry:  Hubu,
+			seniority: 3,
+		},
+		{
+			name: "tie between ministries goes to the first ministry",
+			costs: map[MinistryID]map[Seniority]int{
+				Bingbu: {3: 2, 4: 1},
+				Hubu:   {3: 1, 5: 2},
+				Gongbu: {3: 2, 4: 2},
+			},
+			ministry:  Bingbu,
+			seniority: 4,
+		},
+		{
+			name: "bribed officials are skipped",
+			costs: map[MinistryID]map[Seniority]int{
+				Bingbu: {3: 1, 4: 3},
+				Hubu:   {3: 2, 5: 2},
+				Gongbu: {3: 2, 4: 2},
+			},
+			bribed:    map[MinistryID]map[Seniority]int{Bingbu: {3: 0}},
+			ministry:  Hubu,
+			seniority: 3,
+		},
+		{
+			name: "resolved ministries are skipped",
+			costs: map[MinistryID]map[Seniority]int{
+				Bingbu: {3: 1, 4: 1},
+				Hubu:   {3: 3, 5: 2},
+				Gongbu: {3: 3, 4: 3},
+			},
+			resolved:  []MinistryID{Bingbu},
+			ministry:  Hubu,
+			seniority: 5,
+		},
+		{
+			name: "no official left to bribe",
+			costs: map[MinistryID]map[Seniority]int{
+				Bingbu: {3: 1},
+				Hubu:   {3: 1},
+				Gongbu: {3: 1},
+			},
+			bribed: map[MinistryID]map[Seniority]int{Bingbu: {3: 0}, Hubu: {3: 1}, Gongbu: {3: 0}},
+			none:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 2, TwoPlayerVariant)
+			g.Candidates = nil
+			for _, mid := range ministeryIDS {
+				setOfficials(g.Ministries[mid], tt.costs[mid], tt.bribed[mid])
+			}
+			for _, mid := range tt.resolved {
+				g.Ministries[mid].Resolved = true
+			}
+
+			g.neutralFamilyRound()
+
+			for _, mid := range ministeryIDS {
+				for seniority, o := range g.Ministries[mid].Officials {
+					want := !tt.none && mid == tt.ministry && seniority == tt.seniority
+					if got := o.PlayerID == NeutralPlayerID; got != want {
+						t.Errorf("%s official %d bribed by neutral family: got %v, want %v",
+							g.Ministries[mid].Name(), seniority, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNeutralNominate(t *testing.T) {
+	const p0 = 0
+	tests := []struct {
+		name              string
+		round             int
+		playerID, otherID int
+		wantPlayerID      int
+		wantOtherID       int
+	}{
+		{"not in the first round", 1, NoPlayerID, NoPlayerID, NoPlayerID, NoPlayerID},
+		{"empty candidate", 2, NoPlayerID, NoPlayerID, NeutralPlayerID, NoPlayerID},
+		{"candidate of one player", 2, p0, NoPlayerID, p0, NeutralPlayerID},
+		{"candidate of two players", 2, p0, 1, p0, 1},
+		{"candidate already neutral", 2, NeutralPlayerID, NoPlayerID, NeutralPlayerID, NoPlayerID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 2, TwoPlayerVariant)
+			g.Round = tt.round
+			can := g.Candidate()
+			can.Variant = AnyCandidate1
+			can.PlayerID, can.OtherPlayerID = tt.playerID, tt.otherID
+
+			g.neutralNominate()
+
+			if can.PlayerID != tt.wantPlayerID || can.OtherPlayerID != tt.wantOtherID {
+				t.Errorf("candidate players: got %d and %d, want %d and %d",
+					can.PlayerID, can.OtherPlayerID, tt.wantPlayerID, tt.wantOtherID)
+			}
+		})
+	}
+}
+
+func TestNeutralMinistryResolution(t *testing.T) {
+	const (
+		p0 = 0
+		p1 = 1
+		n  = NeutralPlayerID
+	)
+	tests := []struct {
+		name          string
+		holders       map[Seniority]int
+		wantMinister  int
+		wantSecretary int
+		wantDecider   int // Player awaited to decide, if resolution is not complete
+	}{
+		{
+			name:          "neutral transfers to the player with most influence",
+			holders:       map[Seniority]int{1: p1, 2: p0, 3: p0, 4: p0, 5: p1, 6: n},
+			wantMinister:  p0,
+			wantSecretary: p1,
+			wantDecider:   NoPlayerID,
+		},
+		{
+			name:          "neutral transfers to the tied player holding the more senior official",
+			holders:       map[Seniority]int{1: p1, 2: p0, 3: p0, 4: p1, 5: n},
+			wantMinister:  p1,
+			wantSecretary: p0,
+			wantDecider:   NoPlayerID,
+		},
+		{
+			name:          "player having least influence chooses to whom to transfer",
+			holders:       map[Seniority]int{1: p0, 2: p1, 3: p1, 4: p1, 5: n, 6: n},
+			wantMinister:  NoPlayerID,
+			wantSecretary: NoPlayerID,
+			wantDecider:   p0,
+		},
+		{
+			name:          "two holders need no transfer",
+			holders:       map[Seniority]int{1: n, 2: p1, 3: p1},
+			wantMinister:  p1,
+			wantSecretary: n,
+			wantDecider:   NoPlayerID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, g := newTestGame(t, 2, TwoPlayerVariant)
+			m := g.Ministries[Bingbu]
+			costs := make(map[Seniority]int)
+			for seniority := range tt.holders {
+				costs[seniority] = 1
+			}
+			setOfficials(m, costs, tt.holders)
+
+			completed := g.initMinistryResolution(c, m)
+
+			if tt.wantDecider != NoPlayerID {
+				switch {
+				case completed:
+					t.Fatal("resolution completed, want decision awaited")
+				case g.Decision == nil || g.Decision.PlayerID != tt.wantDecider:
+					t.Fatalf("decision: got %+v, want decision of %d", g.Decision, tt.wantDecider)
+				}
+				return
+			}
+
+			switch {
+			case !completed || !m.Resolved:
+				t.Fatalf("resolution not completed, decision %+v", g.Decision)
+			case m.MinisterID != tt.wantMinister || m.SecretaryID != tt.wantSecretary:
+				t.Errorf("minister and secretary: got %d and %d, want %d and %d",
+					m.MinisterID, m.SecretaryID, tt.wantMinister, tt.wantSecretary)
+			}
+		})
+	}
+}
+
+func TestNeutralExamination(t *testing.T) {
+	const p0 = 0
+	tests := []struct {
+		name        string
+		playerID    int
+		otherID     int
+		playerCoins []int
+		otherCoins  []int
+		deckCoins   []int
+		wantNeutral bool // Whether the neutral student is promoted
+	}{
+		{
+			name:        "uncontested neutral student",
+			playerID:    NeutralPlayerID,
+			otherID:     NoPlayerID,
+			wantNeutral: true,
+		},
+		{
+			name:        "neutral tutoring loses",
+			playerID:    p0,
+			otherID:     NeutralPlayerID,
+			playerCoins: []int{3},
+			deckCoins:   []int{1, 1},
+		},
+		{
+			name:        "neutral tutoring wins",
+			playerID:    p0,
+			otherID:     NeutralPlayerID,
+			playerCoins: []int{3},
+			deckCoins:   []int{2, 2},
+			wantNeutral: true,
+		},
+		{
+			name:        "tie goes to the first nominated student",
+			playerID:    NeutralPlayerID,
+			otherID:     p0,
+			otherCoins:  []int{3},
+			deckCoins:   []int{2, 1},
+			wantNeutral: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, g := newTestGame(t, 2, TwoPlayerVariant)
+			cm := g.Players()[1]
+			g.SetChiefMinister(cm)
+			g.Phase = ImperialExamination
+			can := g.Candidate()
+			can.Variant = AnyCandidate1
+			can.PlayerID, can.OtherPlayerID = tt.playerID, tt.otherID
+			can.PlayerCards, can.OtherPlayerCards = conCards(tt.playerCoins), conCards(tt.otherCoins)
+			g.ConDeck = conCards(tt.deckCoins)
+			before := neutralOfficials(g)
+
+			if err := g.resolveExamination(); err != nil {
+				t.Fatal(err)
+			}
+
+			promoted := neutralOfficials(g) > before
+			if promoted != tt.wantNeutral {
+				t.Fatalf("neutral student promoted: got %v, want %v", promoted, tt.wantNeutral)
+			}
+
+			want := g.PlayerByID(p0)
+			if tt.wantNeutral {
+				// The Chief Minister finishes the turn for the neutral family.
+				want = cm
+			}
+			if cp := g.CurrentPlayer(); !cp.Equal(want) {
+				t.Errorf("current player: got %v, want %v", cp, want)
+			}
+		})
+	}
+}
+
+func conCards(coins []int) ConCards {
+	cards := make(ConCards, len(coins))
+	for i, c := range coins {
+		cards[i] = &ConCard{Coins: c}
+	}
+	return cards
+}
+
+func neutralOfficials(g *Game) int {
+	count := 0
+	for _, m := range g.Ministries {
+		for _, id := range holdersOf(m) {
+			if id == NeutralPlayerID {
+				count++
+			}
+		}
+	}
+	return count
+}
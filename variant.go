@@ -0,0 +1,330 @@
+package confucius
+
+import (
+	"strings"
+)
+
+// GameVariantID identifies a house rule or official variant that may be enabled when a game is created.
+// Not to be confused with VariantID, which identifies the official tile variants.
+type GameVariantID int
+type GameVariantIDS []GameVariantID
+
+const (
+	BasicVariant GameVariantID = iota
+	AdmiralVariant
+	ShortWallVariant
+	PrestigiousTitlesVariant
+	ShipwrightsVariant
+	GenerousTreasuryVariant
+	TwoPlayerVariant
+	SoloVariant
+)
+
+// gameVariantIDS provides the order in which variants are offered and described.
+var gameVariantIDS = GameVariantIDS{
+	BasicVariant,
+	AdmiralVariant,
+	ShortWallVariant,
+	PrestigiousTitlesVariant,
+	ShipwrightsVariant,
+	GenerousTreasuryVariant,
+	TwoPlayerVariant,
+	SoloVariant,
+}
+
+// TitleID identifies the titles awarded during end game scoring.
+type TitleID int
+
+const (
+	ChiefMinisterTitle TitleID = iota
+	AdmiralTitle
+	GeneralTitle
+)
+
+var titleIDStrings = map[TitleID]string{
+	ChiefMinisterTitle: "Chief Minister",
+	AdmiralTitle:       "Admiral",
+	GeneralTitle:       "General",
+}
+
+func (t TitleID) String() string {
+	return titleIDStrings[t]
+}
+
+// GameVariant describes a named rule change.  Each hook is optional and receives the value the
+// standard rules (and any earlier variants) produced, returning the value to use instead.
+// NumPlayers restricts the variant to games having that number of players, zero permitting any number.
+type GameVariant struct {
+	ID          GameVariantID
+	Name        string
+	Description string
+	NumPlayers  int
+
+	start            func(*Game)
+	newRound         func(*Game)
+	requiredCubesFor func(p *Player, id SpaceID, cubes int) int
+	junkCostFor      func(p *Player, junks, cost int) int
+	armyCost         func(p *Player, cost int) int
+	endGame          func(g *Game, end bool) bool
+	titlePoints      func(g *Game, title TitleID, points int) int
+}
+
+var gameVariants = map[GameVariantID]*GameVariant{
+	BasicVariant: {
+		ID:          BasicVariant,
+		Name:        "Basic Game",
+		Description: "Play without the Petition Emperor action and Emperor's Reward cards.",
+	},
+	AdmiralVariant: {
+		ID:          AdmiralVariant,
+		Name:        "Admiral Variant",
+		Description: "The Admiral wins ties for first place.",
+	},
+	ShortWallVariant: {
+		ID:          ShortWallVariant,
+		Name:        "Short Wall",
+		Description: "The game ends once seven sections of the Great Wall have been built.",
+		endGame: func(g *Game, end bool) bool {
+			return end || g.Wall >= 7
+		},
+	},
+	PrestigiousTitlesVariant: {
+		ID:          PrestigiousTitlesVariant,
+		Name:        "Prestigious Titles",
+		Description: "The Chief Minister, Admiral and General titles are each worth 2 points.",
+		titlePoints: func(g *Game, title TitleID, points int) int {
+			return points + 1
+		},
+	},
+	ShipwrightsVariant: {
+		ID:          ShipwrightsVariant,
+		Name:        "Shipwrights",
+		Description: "All players buy junks at the Gongbu discounted price.",
+		junkCostFor: func(p *Player, junks, cost int) int {
+			discountedJunks := []int{0, 1, 2, 4, 7}
+			if junks >= 0 && junks < len(discountedJunks) && discountedJunks[junks] < cost {
+				return discountedJunks[junks]
+			}
+			return cost
+		},
+	},
+	GenerousTreasuryVariant: {
+		ID:          GenerousTreasuryVariant,
+		Name:        "Generous Treasury",
+		Description: "Each player begins the game with an additional Confucius card.",
+		start: func(g *Game) {
+			for _, p := range g.Players() {
+				if card := g.DrawConCard(); card != nil {
+					p.ConCardHand.Append(card)
+				}
+			}
+		},
+	},
+	TwoPlayerVariant: {
+		ID:          TwoPlayerVariant,
+		Name:        "Two Player Rules",
+		Description: "A neutral family bribes officials and nominates students each round.",
+		NumPlayers:  2,
+		start:       (*Game).createNeutralFamily,
+		newRound:    (*Game).neutralFamilyRound,
+	},
+	SoloVariant: {
+		ID:          SoloVariant,
+		Name:        "Solo",
+		Description: "Play alone against the Emperor's court, whose cards direct its actions each round.",
+		NumPlayers:  1,
+		start:       (*Game).createCourt,
+		newRound:    (*Game).courtRound,
+	},
+}
+
+// GameVariants returns the registered variants in the order they should be offered.
+func GameVariants() []*GameVariant {
+	vs := make([]*GameVariant, len(gameVariantIDS))
+	for i, id := range gameVariantIDS {
+		vs[i] = gameVariants[id]
+	}
+	return vs
+}
+
+func (id GameVariantID) String() string {
+	if v, ok := gameVariants[id]; ok {
+		return v.Name
+	}
+	return ""
+}
+
+func (ids GameVariantIDS) include(id GameVariantID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasVariant reports whether the variant identified by id is enabled for the game.
+func (g *Game) HasVariant(id GameVariantID) bool {
+	return g.Variants.include(id)
+}
+
+// setVariants enables the given variants, ignoring unknown and duplicate ids.
+func (g *Game) setVariants(ids GameVariantIDS) {
+	g.Variants = nil
+	for _, id := range gameVariantIDS {
+		if ids.include(id) {
+			g.Variants = append(g.Variants, id)
+		}
+	}
+}
+
+// variants returns the enabled variants in registry order.
+func (g *Game) variants() []*GameVariant {
+	var vs []*GameVariant
+	for _, id := range gameVariantIDS {
+		if g.HasVariant(id) {
+			vs = append(vs, gameVariants[id])
+		}
+	}
+	return vs
+}
+
+// VariantNames returns the names of the enabled variants.
+func (g *Game) VariantNames() []string {
+	var names []string
+	for _, v := range g.variants() {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func (g *Game) variantStart() {
+	for _, v := range g.variants() {
+		if v.start != nil {
+			v.start(g)
+		}
+	}
+}
+
+// variantNewRound runs the new round hooks of the enabled variants.  It is called at the start of
+// every round, including the first.
+func (g *Game) variantNewRound() {
+	for _, v := range g.variants() {
+		if v.newRound != nil {
+			v.newRound(g)
+		}
+	}
+}
+
+func (g *Game) variantRequiredCubesFor(p *Player, id SpaceID, cubes int) int {
+	for _, v := range g.variants() {
+		if v.requiredCubesFor != nil {
+			cubes = v.requiredCubesFor(p, id, cubes)
+		}
+	}
+	return cubes
+}
+
+func (g *Game) variantJunkCostFor(p *Player, junks, cost int) int {
+	for _, v := range g.variants() {
+		if v.junkCostFor != nil {
+			cost = v.junkCostFor(p, junks, cost)
+		}
+	}
+	return cost
+}
+
+func (g *Game) variantArmyCost(p *Player, cost int) int {
+	for _, v := range g.variants() {
+		if v.armyCost != nil {
+			cost = v.armyCost(p, cost)
+		}
+	}
+	return cost
+}
+
+func (g *Game) variantEndGame(end bool) bool {
+	for _, v := range g.variants() {
+		if v.endGame != nil {
+			end = v.endGame(g, end)
+		}
+	}
+	return end
+}
+
+func (g *Game) titlePoints(title TitleID) int {
+	points := 1
+	for _, v := range g.variants() {
+		if v.titlePoints != nil {
+			points = v.titlePoints(g, title, points)
+		}
+	}
+	return points
+}
+
+// validateVariants returns an error if an enabled variant cannot be played with the number of players.
+func (g *Game) validateVariants() error {
+	for _, v := range g.variants() {
+		if v.NumPlayers != 0 && v.NumPlayers != g.NumPlayers {
+			return g.vError("error.variant-requires-players", v.Name, v.NumPlayers)
+		}
+	}
+	return nil
+}
+
+// variantsFromForm returns the variants selected on the create form.  The legacy basic-game and
+// admiral-variant checkboxes are still honoured.
+func variantsFromForm(ids []int, basic, admiral bool) GameVariantIDS {
+	var vids GameVariantIDS
+	for _, id := range ids {
+		vids = append(vids, GameVariantID(id))
+	}
+	if basic {
+		vids = append(vids, BasicVariant)
+	}
+	if admiral {
+		vids = append(vids, AdmiralVariant)
+	}
+	return vids
+}
+
+// migrateVariants converts games saved before variants were introduced.
+func (g *Game) migrateVariants() {
+	if g.BasicGame && !g.HasVariant(BasicVariant) {
+		g.Variants = append(g.Variants, BasicVariant)
+	}
+	if g.AdmiralVariant && !g.HasVariant(AdmiralVariant) {
+		g.Variants = append(g.Variants, AdmiralVariant)
+	}
+	g.BasicGame, g.AdmiralVariant = false, false
+	g.setVariants(g.Variants)
+}
+
+func (g *Game) options() string {
+	l := g.Locale()
+	s := l.Sprintf("header.advanced")
+	if g.HasVariant(BasicVariant) {
+		s = l.Sprintf("header.basic")
+	}
+
+	var names []string
+	for _, v := range g.variants() {
+		if v.ID != BasicVariant {
+			names = append(names, l.Name(v.Name))
+		}
+	}
+
+	if len(names) == 0 {
+		return l.Sprintf("header.without-variants", s)
+	}
+	return l.Sprintf("header.with-variants", s, l.ToSentence(names))
+}
+
+// VariantDescriptions returns a description of each enabled variant.
+func (g *Game) VariantDescriptions() string {
+	var ds []string
+	for _, v := range g.variants() {
+		ds = append(ds, v.Name+": "+v.Description)
+	}
+	return strings.Join(ds, "\n")
+}